@@ -71,6 +71,24 @@ type ColorBook struct {
 	KeyColorPage  uint16    `json:"key_color_page"`
 	ColorType     ColorType `json:"color_type"`
 	Colors        []*Color  `json:"colors"`
+	SpotFunction  string    `json:"spot_function,omitempty"` // SpotFunctionProcess or SpotFunctionSpot; empty derives it from ColorType
+}
+
+// IsSpot reports whether the book describes spot inks rather than process colors.
+func (b *ColorBook) IsSpot() bool {
+	return b.spotFunction() == SpotFunctionSpot
+}
+
+// spotFunction returns the book's spot function identifier, defaulting to spot
+// colors for LAB books and process colors otherwise.
+func (b *ColorBook) spotFunction() string {
+	if b.SpotFunction != "" {
+		return b.SpotFunction
+	}
+	if b.ColorType == ColorTypeLab {
+		return SpotFunctionSpot
+	}
+	return SpotFunctionProcess
 }
 
 func (b *ColorBook) MarshalBinary() ([]byte, error) {
@@ -158,16 +176,7 @@ func (b *ColorBook) MarshalBinary() ([]byte, error) {
 	}
 
 	// Write spot function identifier (required for Photoshop CS+)
-	var spotFunc string
-	switch b.ColorType {
-	case ColorTypeRGB, ColorTypeCMYK:
-		spotFunc = SpotFunctionProcess
-	case ColorTypeLab:
-		spotFunc = SpotFunctionSpot
-	default:
-		spotFunc = SpotFunctionProcess // Default to process
-	}
-	if _, err := buf.WriteString(spotFunc); err != nil {
+	if _, err := buf.WriteString(b.spotFunction()); err != nil {
 		return nil, fmt.Errorf("failed to write spot function: %w", err)
 	}
 
@@ -279,6 +288,7 @@ func (b *ColorBook) UnmarshalBinary(data []byte) (err error) {
 			sfStr := string(spotFunc)
 			if sfStr == SpotFunctionProcess || sfStr == SpotFunctionSpot {
 				slog.Debug("parsed spot function", slog.String("value", sfStr))
+				b.SpotFunction = sfStr
 			}
 		}
 	}
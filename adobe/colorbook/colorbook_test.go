@@ -102,4 +102,45 @@ func TestNewColor(t *testing.T) {
 	if color.Components[0] != 255 || color.Components[1] != 0 || color.Components[2] != 0 {
 		t.Errorf("Color Components = %v, want [255 0 0]", color.Components)
 	}
-}
\ No newline at end of file
+}
+
+func TestSpotFunction(t *testing.T) {
+	tests := map[string]struct {
+		colorType    colorbook.ColorType
+		spotFunction string
+		wantSpot     bool
+	}{
+		"RGB default": {colorbook.ColorTypeRGB, "", false},
+		"Lab default": {colorbook.ColorTypeLab, "", true},
+		"CMYK spot":   {colorbook.ColorTypeCMYK, colorbook.SpotFunctionSpot, true},
+		"Lab process": {colorbook.ColorTypeLab, colorbook.SpotFunctionProcess, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cb := &colorbook.ColorBook{
+				Version:      colorbook.DefaultVersion,
+				ColorType:    tt.colorType,
+				Title:        "Test",
+				SpotFunction: tt.spotFunction,
+				Colors:       []*colorbook.Color{{Name: "One", Components: [4]byte{10, 20, 30, 40}}},
+			}
+			if cb.IsSpot() != tt.wantSpot {
+				t.Errorf("IsSpot() = %v, want %v", cb.IsSpot(), tt.wantSpot)
+			}
+
+			data, err := cb.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+
+			var got colorbook.ColorBook
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if got.IsSpot() != tt.wantSpot {
+				t.Errorf("round trip IsSpot() = %v, want %v", got.IsSpot(), tt.wantSpot)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package contrast
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kennyp/palette/cmd/palette/shared"
+	"github.com/urfave/cli/v3"
+)
+
+// Command returns the contrast subcommand.
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "contrast",
+		Usage: "Report the contrast of every foreground/background pair in a palette",
+		Description: `Report the WCAG 2.1 contrast ratio and APCA lightness contrast (Lc) of
+every palette color as text on every other palette color as background.
+
+The table shows text colors as rows and background colors as columns.
+Each cell holds the WCAG ratio and the best level the pair passes:
+   AAA      - 7:1 or more, for normal text
+   AA       - 4.5:1 or more, for normal text (AAA for large text)
+   AA Large - 3:1 or more, for large text and user interface components
+   Fail     - below 3:1
+
+Examples:
+   palette contrast -i brand.json
+   palette contrast -i brand.aco --metric apca
+   palette contrast -i brand.csv --format json > contrast.json`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "input",
+				Aliases:  []string{"i"},
+				Usage:    "Input file path (required)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "Source format (auto-detect if omitted): .acb, .aco, .csv, .json",
+			},
+			&cli.StringFlag{
+				Name:  "auto-name",
+				Usage: "Name unnamed colors after the nearest named color in a set: css, x11, extended",
+			},
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"f"},
+				Usage:   "Output format: table, json",
+				Value:   "table",
+			},
+			&cli.StringFlag{
+				Name:  "metric",
+				Usage: "Value shown in table cells: wcag (ratio and level), apca (Lc)",
+				Value: "wcag",
+			},
+		},
+		Action: run,
+	}
+}
+
+func run(ctx context.Context, cmd *cli.Command) error {
+	inputPath := cmd.String("input")
+
+	// Check if input file exists
+	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+		return cli.Exit(fmt.Sprintf("Error: input file does not exist: %s", inputPath), 1)
+	}
+
+	p, err := shared.ImportFileAutoNamed(inputPath, cmd.String("from"), cmd.String("auto-name"))
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	if err := shared.WriteContrastReport(cmd.Root().Writer, p, cmd.String("format"), cmd.String("metric")); err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	return nil
+}
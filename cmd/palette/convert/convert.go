@@ -20,9 +20,17 @@ func Command() *cli.Command {
    .csv - Comma-Separated Values
    .json - JSON
 
+Color spaces for --colorspace:
+` + shared.ColorSpaceHelp() + `
+
 Examples:
    palette convert -i colors.aco -o colors.json
    palette convert -i palette.acb -o palette.csv --colorspace RGB
+   palette convert -i brand.json -o print.aco --output-profile CoatedFOGRA39.icc
+   palette convert -i brand.json -o print.aco --colorspace CMYK --black-generation medium --ink-limit 300
+   palette convert -i colors.csv -o colors.json --auto-name extended
+   palette convert -i brand.json -o brand.csv --precision 2
+   palette convert -i brand.json -o brand-oklch.json --colorspace OKLCH --precision full
    palette convert --input data.json --output output.aco`,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
@@ -47,7 +55,42 @@ Examples:
 			},
 			&cli.StringFlag{
 				Name:  "colorspace",
-				Usage: "Convert all colors to specified color space: RGB, CMYK, LAB, HSB",
+				Usage: "Convert all colors to specified color space: " + shared.ColorSpaceList(),
+			},
+			&cli.StringFlag{
+				Name:  "gamut",
+				Usage: "Gamut mapping for colors outside the target color space: clip, lch, oklch, css",
+				Value: "clip",
+			},
+			&cli.StringFlag{
+				Name:  "black-generation",
+				Usage: "Black generation when converting to CMYK: maximum, heavy, medium, light, ucr, none",
+				Value: "maximum",
+			},
+			&cli.FloatFlag{
+				Name:  "ink-limit",
+				Usage: "Total area coverage limit in percent when converting to CMYK, e.g. 300. 0 for no limit.",
+			},
+			&cli.StringFlag{
+				Name:  "input-profile",
+				Usage: "ICC profile describing the input colors. If omitted, colors are used at their own colorimetric value.",
+			},
+			&cli.StringFlag{
+				Name:  "output-profile",
+				Usage: "ICC profile to convert colors into, e.g. a CMYK press profile",
+			},
+			&cli.StringFlag{
+				Name:  "intent",
+				Usage: "Rendering intent for profile conversion: perceptual, relative, saturation, absolute",
+				Value: "relative",
+			},
+			&cli.StringFlag{
+				Name:  "auto-name",
+				Usage: "Name unnamed colors after the nearest named color in a set: css, x11, extended",
+			},
+			&cli.StringFlag{
+				Name:  "precision",
+				Usage: "Decimal places for values written to .csv and .json, or full to write every color without loss. Format default if omitted.",
 			},
 			&cli.StringFlag{
 				Name:  "book-id",
@@ -65,6 +108,18 @@ func run(ctx context.Context, cmd *cli.Command) error {
 	toFormat := cmd.String("to")
 	colorSpace := cmd.String("colorspace")
 	bookID := cmd.String("book-id")
+	opts := shared.ConvertOptions{
+		ColorSpace:      colorSpace,
+		BookID:          bookID,
+		InputProfile:    cmd.String("input-profile"),
+		OutputProfile:   cmd.String("output-profile"),
+		Intent:          cmd.String("intent"),
+		GamutMapping:    cmd.String("gamut"),
+		AutoName:        cmd.String("auto-name"),
+		BlackGeneration: cmd.String("black-generation"),
+		InkLimit:        cmd.Float("ink-limit"),
+		Precision:       cmd.String("precision"),
+	}
 
 	// Validate color space if provided
 	if colorSpace != "" {
@@ -79,7 +134,7 @@ func run(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	// Perform conversion
-	if err := shared.ConvertFile(inputPath, outputPath, fromFormat, toFormat, colorSpace, bookID); err != nil {
+	if err := shared.ConvertFileWithOptions(inputPath, outputPath, fromFormat, toFormat, opts); err != nil {
 		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
 	}
 
@@ -94,6 +149,9 @@ func run(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	fmt.Fprintf(cmd.Root().Writer, "Successfully converted %s to %s\n", fromFmt, toFmt)
+	if opts.OutputProfile != "" {
+		fmt.Fprintf(cmd.Root().Writer, "Colors converted to profile %s (%s intent)\n", opts.OutputProfile, opts.Intent)
+	}
 	if colorSpace != "" {
 		fmt.Fprintf(cmd.Root().Writer, "Colors converted to %s color space\n", colorSpace)
 	}
@@ -0,0 +1,105 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kennyp/palette/cmd/palette/shared"
+	"github.com/urfave/cli/v3"
+)
+
+// Command returns the generate subcommand.
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "generate",
+		Usage: "Generate a color harmony palette from a base color",
+		Description: `Generate a palette from a base color using a color harmony scheme.
+Hues are rotated in OKLCH (or CIE LCh), keeping the base color's
+lightness and chroma.
+
+Schemes:
+   complementary, split-complementary, analogous, triadic, tetradic, monochromatic
+
+Examples:
+   palette generate --base "#ff8800" --scheme triadic -o triadic.json
+   palette generate --base "oklch(0.6 0.15 250)" --scheme analogous --count 5 -o blues.aco
+   palette generate --base rebeccapurple --scheme monochromatic --space lch -o purple.csv`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "base",
+				Aliases:  []string{"b"},
+				Usage:    "Base color as a CSS color, e.g. \"#ff8800\", \"oklch(0.7 0.15 50)\" or a color name (required)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:    "scheme",
+				Aliases: []string{"s"},
+				Usage:   "Harmony scheme: complementary, split-complementary, analogous, triadic, tetradic, monochromatic",
+				Value:   "complementary",
+			},
+			&cli.StringFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Usage:    "Output file path (required)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Usage: "Target format (infer from output extension if omitted): .acb, .aco, .csv, .json",
+			},
+			&cli.StringFlag{
+				Name:  "space",
+				Usage: "Space to rotate hues in: oklch, lch",
+				Value: "oklch",
+			},
+			&cli.FloatFlag{
+				Name:  "angle",
+				Usage: "Hue spread in degrees for split-complementary (default 30), analogous (default 30) and tetradic (default 90)",
+			},
+			&cli.IntFlag{
+				Name:  "count",
+				Usage: "Number of colors for analogous (default 3) and monochromatic (default 5)",
+			},
+			&cli.StringFlag{
+				Name:  "gamut",
+				Usage: "RGB space to bring generated colors into by chroma reduction: sRGB, DisplayP3, AdobeRGB, ProPhoto, Rec2020, or none",
+				Value: "sRGB",
+			},
+			&cli.StringFlag{
+				Name:  "name",
+				Usage: "Palette name (default: scheme and base color)",
+			},
+		},
+		Action: run,
+	}
+}
+
+func run(ctx context.Context, cmd *cli.Command) error {
+	opts := shared.GenerateOptions{
+		Base:   cmd.String("base"),
+		Scheme: cmd.String("scheme"),
+		Space:  cmd.String("space"),
+		Angle:  cmd.Float("angle"),
+		Count:  cmd.Int("count"),
+		Gamut:  cmd.String("gamut"),
+		Name:   cmd.String("name"),
+	}
+	if opts.Gamut == "none" {
+		opts.Gamut = ""
+	}
+
+	p, err := shared.GenerateHarmony(opts)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	outputPath := cmd.String("output")
+	if err := shared.ExportFile(p, outputPath, cmd.String("to")); err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	fmt.Fprintf(cmd.Root().Writer, "Generated %s palette with %d colors\n", p.Name, p.Len())
+	fmt.Fprintf(cmd.Root().Writer, "Output written to: %s\n", outputPath)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package ink
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kennyp/palette/cmd/palette/shared"
+	"github.com/urfave/cli/v3"
+)
+
+// Command returns the ink subcommand.
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "ink",
+		Usage: "Report the CMYK ink coverage of every color in a palette",
+		Description: `Report the cyan, magenta, yellow and black coverage of every palette
+color, and its total area coverage (TAC) in percent.
+
+CMYK colors report the inks they already have. Other colors are separated
+with the given black generation:
+   maximum - replace the whole gray component with black (GCR, the default)
+   heavy   - heavy GCR, starting at 10% gray
+   medium  - medium GCR, starting at 20% gray
+   light   - light GCR, starting at 40% gray
+   ucr     - under color removal: black only in neutral shadows
+   none    - no black; grays are printed with cyan, magenta and yellow
+
+With --ink-limit, separated colors are kept within the limit and colors
+above it are marked.
+
+Examples:
+   palette ink -i brand.aco
+   palette ink -i brand.json --black-generation medium --ink-limit 300
+   palette ink -i brand.csv --format json > ink.json`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "input",
+				Aliases:  []string{"i"},
+				Usage:    "Input file path (required)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "Source format (auto-detect if omitted): .acb, .aco, .csv, .json",
+			},
+			&cli.StringFlag{
+				Name:  "auto-name",
+				Usage: "Name unnamed colors after the nearest named color in a set: css, x11, extended",
+			},
+			&cli.StringFlag{
+				Name:  "black-generation",
+				Usage: "Black generation for colors that are not CMYK: maximum, heavy, medium, light, ucr, none",
+				Value: "maximum",
+			},
+			&cli.FloatFlag{
+				Name:  "ink-limit",
+				Usage: "Total area coverage limit in percent, e.g. 300. 0 for no limit.",
+			},
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"f"},
+				Usage:   "Output format: table, json",
+				Value:   "table",
+			},
+		},
+		Action: run,
+	}
+}
+
+func run(ctx context.Context, cmd *cli.Command) error {
+	inputPath := cmd.String("input")
+
+	sep, err := shared.ParseSeparation(cmd.String("black-generation"), cmd.Float("ink-limit"))
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	// Check if input file exists
+	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+		return cli.Exit(fmt.Sprintf("Error: input file does not exist: %s", inputPath), 1)
+	}
+
+	p, err := shared.ImportFileAutoNamed(inputPath, cmd.String("from"), cmd.String("auto-name"))
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	if err := shared.WriteInkReport(cmd.Root().Writer, p, cmd.String("format"), sep); err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	return nil
+}
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kennyp/palette/cmd/palette/contrast"
 	"github.com/kennyp/palette/cmd/palette/convert"
+	"github.com/kennyp/palette/cmd/palette/generate"
+	"github.com/kennyp/palette/cmd/palette/ink"
 	"github.com/kennyp/palette/cmd/palette/serve"
+	"github.com/kennyp/palette/cmd/palette/simulate"
 	"github.com/urfave/cli/v3"
 )
 
@@ -34,6 +38,10 @@ For more information about a specific command, use:
   palette <command> --help`,
 		Commands: []*cli.Command{
 			convert.Command(),
+			contrast.Command(),
+			generate.Command(),
+			ink.Command(),
+			simulate.Command(),
 			serve.Command(),
 		},
 		Flags: []cli.Flag{
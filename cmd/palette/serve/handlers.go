@@ -2,6 +2,7 @@ package serve
 
 import (
 	"fmt"
+	"html/template"
 	"io"
 	"net/http"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"github.com/ajg/form"
 	"github.com/go-chi/render"
 	"github.com/kennyp/palette/cmd/palette/shared"
+	"github.com/kennyp/palette/color"
 )
 
 func init() {
@@ -37,6 +39,14 @@ func init() {
 //go:embed templates/index.html
 var indexHTML string
 
+// indexTemplate renders the main page, filling in the color spaces from the registry.
+var indexTemplate = template.Must(template.New("index").Parse(indexHTML))
+
+// IndexData is the data the main page is rendered with.
+type IndexData struct {
+	ColorSpaces []ColorSpaceInfo
+}
+
 //go:embed templates/favicon.svg
 var faviconSVG []byte
 
@@ -56,7 +66,7 @@ func (e *ErrResponse) Render(w http.ResponseWriter, r *http.Request) error {
 func handleIndex(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(indexHTML))
+	indexTemplate.Execute(w, IndexData{ColorSpaces: colorSpaceInfos()})
 }
 
 // handleFavicon serves the favicon.
@@ -199,16 +209,26 @@ type FormatInfo struct {
 	Description string `json:"description"`
 }
 
-// FormatsList is a list of formats for rendering.
+// ColorSpaceInfo represents information about a color space colors can be converted to.
+type ColorSpaceInfo struct {
+	Name        string   `json:"name"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Description string   `json:"description"`
+	Components  []string `json:"components"`
+	Gamut       string   `json:"gamut,omitempty"` // RGB space bounding the color space, if any
+}
+
+// FormatsList is a list of formats and color spaces for rendering.
 type FormatsList struct {
-	Formats []FormatInfo `json:"formats"`
+	Formats     []FormatInfo     `json:"formats"`
+	ColorSpaces []ColorSpaceInfo `json:"color_spaces"`
 }
 
 func (f *FormatsList) Render(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
-// handleFormats returns JSON array of supported formats.
+// handleFormats returns JSON arrays of supported formats and color spaces.
 func handleFormats(w http.ResponseWriter, r *http.Request) {
 	formats := []FormatInfo{
 		{Extension: ".acb", Description: "Adobe Color Book"},
@@ -217,7 +237,25 @@ func handleFormats(w http.ResponseWriter, r *http.Request) {
 		{Extension: ".json", Description: "JSON"},
 	}
 
-	render.Render(w, r, &FormatsList{Formats: formats})
+	render.Render(w, r, &FormatsList{Formats: formats, ColorSpaces: colorSpaceInfos()})
+}
+
+// colorSpaceInfos describes every color space in the registry.
+func colorSpaceInfos() []ColorSpaceInfo {
+	var spaces []ColorSpaceInfo
+	for _, space := range color.ColorSpaces() {
+		info := ColorSpaceInfo{
+			Name:        space.Name,
+			Aliases:     space.Aliases,
+			Description: space.Description,
+			Components:  space.Components,
+		}
+		if space.Gamut != nil {
+			info.Gamut = space.Gamut.Name
+		}
+		spaces = append(spaces, info)
+	}
+	return spaces
 }
 
 // HealthResponse represents a health check response.
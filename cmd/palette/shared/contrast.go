@@ -0,0 +1,118 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kennyp/palette/palette"
+)
+
+// ContrastReport is the JSON form of a palette's contrast matrix.
+type ContrastReport struct {
+	Palette string                 `json:"palette"`
+	Colors  []string               `json:"colors"`
+	Pairs   []palette.ContrastPair `json:"pairs"` // Every ordered pair of distinct colors
+}
+
+// WriteContrastReport writes the contrast of every pair of colors in p to w. format is
+// "table" (the default) or "json". In a table, metric chooses what each cell shows:
+// "wcag" (the default) for the WCAG 2.1 ratio and level, or "apca" for the APCA Lc value.
+func WriteContrastReport(w io.Writer, p *palette.Palette, format, metric string) error {
+	matrix := p.ContrastMatrix()
+
+	switch strings.ToLower(format) {
+	case "", "table":
+		return writeContrastTable(w, p, matrix, metric)
+
+	case "json":
+		report := ContrastReport{Palette: p.Name, Colors: make([]string, len(p.Colors)), Pairs: []palette.ContrastPair{}}
+		for i, c := range p.Colors {
+			report.Colors[i] = colorLabel(i, c)
+		}
+		for i, row := range matrix {
+			for j, pair := range row {
+				if i != j {
+					report.Pairs = append(report.Pairs, pair)
+				}
+			}
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("failed to write contrast report: %w", err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("invalid report format: %s (must be one of: table, json)", format)
+	}
+}
+
+// writeContrastTable writes the contrast matrix with text colors as rows and
+// background colors as columns.
+func writeContrastTable(w io.Writer, p *palette.Palette, matrix [][]palette.ContrastPair, metric string) error {
+	var cell func(palette.ContrastPair) string
+	switch strings.ToLower(metric) {
+	case "", "wcag":
+		cell = func(pair palette.ContrastPair) string {
+			return fmt.Sprintf("%.2f %s", pair.Ratio, wcagLevel(pair))
+		}
+	case "apca":
+		cell = func(pair palette.ContrastPair) string {
+			return fmt.Sprintf("%.1f", pair.APCA)
+		}
+	default:
+		return fmt.Errorf("invalid contrast metric: %s (must be one of: wcag, apca)", metric)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprint(tw, "Text \\ Background")
+	for i, c := range p.Colors {
+		fmt.Fprintf(tw, "\t%s", colorLabel(i, c))
+	}
+	fmt.Fprintln(tw)
+
+	for i, row := range matrix {
+		fmt.Fprint(tw, colorLabel(i, p.Colors[i]))
+		for j, pair := range row {
+			if i == j {
+				fmt.Fprint(tw, "\t-")
+				continue
+			}
+			fmt.Fprintf(tw, "\t%s", cell(pair))
+		}
+		fmt.Fprintln(tw)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed to write contrast report: %w", err)
+	}
+	return nil
+}
+
+// wcagLevel returns the best WCAG 2.1 level a pair passes.
+func wcagLevel(pair palette.ContrastPair) string {
+	switch {
+	case pair.AAA:
+		return "AAA"
+	case pair.AA:
+		return "AA"
+	case pair.AALarge:
+		return "AA Large"
+	default:
+		return "Fail"
+	}
+}
+
+// colorLabel returns a color's name, or its position if it has none.
+func colorLabel(index int, c palette.NamedColor) string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return fmt.Sprintf("Color %d", index+1)
+}
@@ -6,8 +6,11 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/kennyp/palette/adobe/colorbook"
+	"github.com/kennyp/palette/color"
+	"github.com/kennyp/palette/icc"
 	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 	_ "github.com/kennyp/palette/palette/all" // Initialize format importers/exporters
@@ -19,6 +22,32 @@ import (
 // If colorSpace is non-empty, all colors will be converted to that color space.
 // If bookID is non-empty and toFormat is .acb, it will be used as the BookID (must be 4000-65535).
 func ConvertFile(inputPath, outputPath, fromFormat, toFormat, colorSpace, bookID string) error {
+	return ConvertFileWithOptions(inputPath, outputPath, fromFormat, toFormat, ConvertOptions{
+		ColorSpace: colorSpace,
+		BookID:     bookID,
+	})
+}
+
+// ConvertOptions holds the optional settings for ConvertFileWithOptions.
+type ConvertOptions struct {
+	ColorSpace      string  // Convert all colors to this color space
+	BookID          string  // BookID for ACB export (4000-65535)
+	InputProfile    string  // ICC profile describing the device values of the imported colors
+	OutputProfile   string  // ICC profile to convert colors into
+	Intent          string  // Rendering intent for profile conversion, relative colorimetric if empty
+	GamutMapping    string  // Gamut mapping for color space conversion (clip, lch, oklch, css), clip if empty
+	AutoName        string  // Name set for naming unnamed colors (css, x11, extended), none if empty
+	BlackGeneration string  // Black generation for CMYK conversion (maximum, none, light, medium, heavy, ucr), maximum if empty
+	InkLimit        float64 // Total area coverage limit in percent for CMYK conversion, none if 0
+	Precision       string  // Decimal places for exported values, or "full" for lossless output; the format's default if empty
+}
+
+// ConvertFileWithOptions converts a palette file from one format to another like ConvertFile.
+// If an input or output ICC profile is set, colors are converted through the profiles before
+// any color space conversion.
+func ConvertFileWithOptions(inputPath, outputPath, fromFormat, toFormat string, opts ConvertOptions) error {
+	colorSpace, bookID := opts.ColorSpace, opts.BookID
+
 	// Detect formats from file extensions if not specified
 	if fromFormat == "" {
 		fromFormat = filepath.Ext(inputPath)
@@ -43,25 +72,44 @@ func ConvertFile(inputPath, outputPath, fromFormat, toFormat, colorSpace, bookID
 		return fmt.Errorf("cannot detect output format from file: %s", outputPath)
 	}
 
-	// Open input file
-	inputFile, err := os.Open(inputPath)
+	p, err := ImportFileAutoNamed(inputPath, fromFormat, opts.AutoName)
 	if err != nil {
-		return fmt.Errorf("failed to open input file: %w", err)
+		return err
 	}
-	defer inputFile.Close()
 
-	// Import palette
-	p, err := paletteio.Import(inputFile, fromFormat)
-	if err != nil {
-		return fmt.Errorf("failed to import palette from %s: %w", fromFormat, err)
+	// Convert through ICC profiles if requested
+	if opts.InputProfile != "" || opts.OutputProfile != "" {
+		p, err = ApplyProfiles(p, opts.InputProfile, opts.OutputProfile, opts.Intent)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Convert color space if requested
+	// Convert color space if requested, warning about colors outside its gamut
 	if colorSpace != "" {
-		p, err = p.ConvertToColorSpace(colorSpace)
+		mapping := color.GamutMappingClip
+		if opts.GamutMapping != "" {
+			if mapping, err = color.ParseGamutMapping(opts.GamutMapping); err != nil {
+				return err
+			}
+		}
+
+		var separation color.Separation
+		if separation, err = ParseSeparation(opts.BlackGeneration, opts.InkLimit); err != nil {
+			return err
+		}
+
+		var issues []palette.GamutIssue
+		p, issues, err = p.ConvertToColorSpaceWithOptions(colorSpace, palette.ConvertOptions{
+			GamutMapping: mapping,
+			Separation:   separation,
+		})
 		if err != nil {
 			return fmt.Errorf("failed to convert to color space %s: %w", colorSpace, err)
 		}
+		for _, issue := range issues {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", issue)
+		}
 	}
 
 	// Set custom BookID for ACB export if provided
@@ -76,6 +124,99 @@ func ConvertFile(inputPath, outputPath, fromFormat, toFormat, colorSpace, bookID
 		p.SetMetadata("book_id", colorbook.BookID(id))
 	}
 
+	if opts.Precision == "" {
+		return ExportFile(p, outputPath, toFormat)
+	}
+	precision, err := ParsePrecision(opts.Precision)
+	if err != nil {
+		return err
+	}
+
+	return ExportFileWithPrecision(p, outputPath, toFormat, precision)
+}
+
+// ImportFile reads a palette from inputPath. If fromFormat is empty, it is detected from
+// the input file extension.
+func ImportFile(inputPath, fromFormat string) (*palette.Palette, error) {
+	return ImportFileAutoNamed(inputPath, fromFormat, "")
+}
+
+// ImportFileAutoNamed reads a palette from inputPath like ImportFile, naming unnamed
+// colors after the nearest color in the named set (css, x11 or extended). An empty
+// nameSet leaves colors as the importer named them.
+func ImportFileAutoNamed(inputPath, fromFormat, nameSet string) (*palette.Palette, error) {
+	var set *color.NameSet
+	if nameSet != "" {
+		var ok bool
+		if set, ok = color.LookupNameSet(nameSet); !ok {
+			return nil, fmt.Errorf("invalid name set: %s (must be one of: css, x11, extended)", nameSet)
+		}
+	}
+
+	if fromFormat == "" {
+		fromFormat = filepath.Ext(inputPath)
+	}
+	if fromFormat == "" {
+		return nil, fmt.Errorf("cannot detect input format from file: %s", inputPath)
+	}
+	if !strings.HasPrefix(fromFormat, ".") {
+		fromFormat = "." + fromFormat
+	}
+
+	// Open input file
+	inputFile, err := os.Open(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open input file: %w", err)
+	}
+	defer inputFile.Close()
+
+	// Import palette
+	var p *palette.Palette
+	if set != nil {
+		p, err = paletteio.ImportAutoNamed(inputFile, fromFormat, set)
+	} else {
+		p, err = paletteio.Import(inputFile, fromFormat)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to import palette from %s: %w", fromFormat, err)
+	}
+
+	return p, nil
+}
+
+// ExportFile writes a palette to outputPath, warning on stderr about anything the format
+// cannot store. If toFormat is empty, it is detected from the output file extension.
+func ExportFile(p *palette.Palette, outputPath, toFormat string) error {
+	return exportFile(p, outputPath, toFormat, paletteio.DefaultRegistry.FindExporter)
+}
+
+// ExportFileWithPrecision writes p to outputPath like ExportFile, with values rounded to
+// the given number of decimal places in formats that support it. paletteio.FullPrecision
+// writes every color losslessly where the format allows; see
+// paletteio.Registry.FindExporterWithPrecision.
+func ExportFileWithPrecision(p *palette.Palette, outputPath, toFormat string, precision int) error {
+	return exportFile(p, outputPath, toFormat, func(format string) (paletteio.Exporter, error) {
+		return paletteio.DefaultRegistry.FindExporterWithPrecision(format, precision)
+	})
+}
+
+// exportFile writes p to outputPath with the exporter that find returns for the format.
+func exportFile(p *palette.Palette, outputPath, toFormat string, find func(format string) (paletteio.Exporter, error)) error {
+	if toFormat == "" {
+		toFormat = filepath.Ext(outputPath)
+	}
+	if toFormat == "" {
+		return fmt.Errorf("cannot detect output format from file: %s", outputPath)
+	}
+	if !strings.HasPrefix(toFormat, ".") {
+		toFormat = "." + toFormat
+	}
+
+	exporter, err := find(toFormat)
+	if err != nil {
+		return fmt.Errorf("failed to export palette to %s: %w", toFormat, err)
+	}
+
 	// Create output file
 	outputFile, err := os.Create(outputPath)
 	if err != nil {
@@ -84,13 +225,74 @@ func ConvertFile(inputPath, outputPath, fromFormat, toFormat, colorSpace, bookID
 	defer outputFile.Close()
 
 	// Export palette
-	if err := paletteio.Export(p, outputFile, toFormat); err != nil {
+	if err := exporter.Export(p, outputFile); err != nil {
 		return fmt.Errorf("failed to export palette to %s: %w", toFormat, err)
 	}
 
+	// Warn about anything the output format could not store
+	if reporter, ok := exporter.(paletteio.LossReporter); ok {
+		for _, loss := range reporter.Losses(p) {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", loss)
+		}
+	}
+
 	return nil
 }
 
+// ParsePrecision parses a number of decimal places for exported values, or "full" for
+// paletteio.FullPrecision.
+func ParsePrecision(s string) (int, error) {
+	if strings.EqualFold(s, "full") {
+		return paletteio.FullPrecision, nil
+	}
+
+	places, err := strconv.Atoi(s)
+	if err != nil || places < 0 {
+		return 0, fmt.Errorf("invalid precision: %s (must be full or a number of decimal places)", s)
+	}
+	return places, nil
+}
+
+// ApplyProfiles returns a copy of p with every color converted from inputProfile to
+// outputProfile, given as ICC profile paths. Either path may be empty; see icc.Transform.
+// An empty intent means relative colorimetric.
+func ApplyProfiles(p *palette.Palette, inputProfile, outputProfile, intent string) (*palette.Palette, error) {
+	renderingIntent := icc.IntentRelativeColorimetric
+	if intent != "" {
+		var err error
+		if renderingIntent, err = icc.ParseIntent(intent); err != nil {
+			return nil, err
+		}
+	}
+
+	var src, dst *icc.Profile
+	var err error
+	if inputProfile != "" {
+		if src, err = icc.Open(inputProfile); err != nil {
+			return nil, fmt.Errorf("failed to load input profile: %w", err)
+		}
+	}
+	if outputProfile != "" {
+		if dst, err = icc.Open(outputProfile); err != nil {
+			return nil, fmt.Errorf("failed to load output profile: %w", err)
+		}
+	}
+
+	transform, err := icc.NewTransform(src, dst, renderingIntent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create profile transform: %w", err)
+	}
+
+	converted := p.Clone()
+	for i, c := range converted.Colors {
+		if converted.Colors[i].Color, err = transform.Convert(c.Color); err != nil {
+			return nil, fmt.Errorf("failed to convert color %d (%s): %w", i, c.Name, err)
+		}
+	}
+
+	return converted, nil
+}
+
 // GetSupportedFormats returns a list of file extensions for supported formats.
 func GetSupportedFormats() []string {
 	return []string{".acb", ".aco", ".csv", ".json"}
@@ -105,18 +307,36 @@ func DetectFormat(filename string) string {
 	return ""
 }
 
-// ValidateColorSpace checks if the provided color space is valid.
+// ValidateColorSpace checks that the provided color space is registered. An empty
+// name is valid and means no conversion.
 func ValidateColorSpace(cs string) error {
 	if cs == "" {
 		return nil
 	}
-	validSpaces := []string{"RGB", "CMYK", "LAB", "HSB"}
-	for _, valid := range validSpaces {
-		if strings.EqualFold(cs, valid) {
-			return nil
+	_, err := color.LookupColorSpace(cs)
+	return err
+}
+
+// ColorSpaceList returns the names of the registered color spaces for help text,
+// e.g. "RGB, CMYK, LAB".
+func ColorSpaceList() string {
+	return strings.Join(color.ColorSpaceNames(), ", ")
+}
+
+// ColorSpaceHelp returns one help line per registered color space with its
+// description and aliases, indented to match command descriptions.
+func ColorSpaceHelp() string {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 0, 1, ' ', 0)
+	for _, space := range color.ColorSpaces() {
+		fmt.Fprintf(tw, "   %s\t- %s", space.Name, space.Description)
+		if len(space.Aliases) > 0 {
+			fmt.Fprintf(tw, " (also %s)", strings.Join(space.Aliases, ", "))
 		}
+		fmt.Fprintln(tw)
 	}
-	return fmt.Errorf("invalid color space: %s (must be one of: %s)", cs, strings.Join(validSpaces, ", "))
+	tw.Flush()
+	return strings.TrimSuffix(sb.String(), "\n")
 }
 
 // ConvertFromReader converts a palette from a reader to a writer.
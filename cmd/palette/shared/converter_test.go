@@ -0,0 +1,118 @@
+package shared
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestConvertFileWithColorSpace(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "colors.json")
+	data := `{"name": "Test", "colors": [{"name": "Sky", "rgb": {"r": 31.4, "g": 167.2, "b": 254.9}}]}`
+	if err := os.WriteFile(input, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	want := color.NewRGB64(31.4/255, 167.2/255, 254.9/255).ToXYZ().ToOKLCH()
+
+	tests := map[string]struct {
+		output    string
+		precision string
+		contains  string
+		lossless  bool
+		warn      bool
+	}{
+		"JSON":           {"colors.json", "", `"rgb": {`, false, true},
+		"JSON lossless":  {"colors.json", "full", `"oklch": {`, true, false},
+		"CSV":            {"colors.csv", "", "Sky,31,167,255", false, true},
+		"CSV two places": {"colors.csv", "2", "Sky,31.40,167.20,254.90", false, false},
+		"CSV lossless":   {"colors.csv", "full", `Sky,"OKLCH(`, true, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			output := filepath.Join(dir, strings.ReplaceAll(name, " ", "-")+"-"+tt.output)
+			opts := ConvertOptions{ColorSpace: "oklch", Precision: tt.precision}
+
+			warnings := captureStderr(t, func() {
+				if err := ConvertFileWithOptions(input, output, "", "", opts); err != nil {
+					t.Fatalf("ConvertFileWithOptions() error = %v", err)
+				}
+			})
+
+			written, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(written), tt.contains) {
+				t.Errorf("ConvertFileWithOptions() wrote %s, want %s", written, tt.contains)
+			}
+
+			if tt.lossless {
+				p, err := ImportFile(output, "")
+				if err != nil {
+					t.Fatalf("ImportFile() error = %v", err)
+				}
+				if got, _ := p.Get(0); got.Color != want {
+					t.Errorf("ConvertFileWithOptions() wrote %v, want %v", got.Color, want)
+				}
+			}
+
+			// Values rounded away are reported
+			if warned := strings.Contains(warnings, "color 0 (Sky): full precision dropped"); warned != tt.warn {
+				t.Errorf("ConvertFileWithOptions() warned %q, want the rounded color reported: %v", warnings, tt.warn)
+			}
+		})
+	}
+}
+
+func TestParsePrecision(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		"Full":     {"full", -1, false},
+		"Places":   {"2", 2, false},
+		"Empty":    {"", 0, true},
+		"Negative": {"-1", 0, true},
+		"Invalid":  {"two", 0, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParsePrecision(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePrecision() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePrecision() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// captureStderr returns what fn writes to os.Stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	defer func() { os.Stderr = stderr }()
+
+	fn()
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
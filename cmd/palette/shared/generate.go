@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kennyp/palette/color"
+	"github.com/kennyp/palette/palette"
+)
+
+// GenerateOptions holds the settings for GenerateHarmony.
+type GenerateOptions struct {
+	Base   string  // Base color as a CSS color, such as "#ff8800" or "oklch(0.7 0.15 50)"
+	Scheme string  // Harmony scheme (complementary, split-complementary, analogous, triadic, tetradic, monochromatic)
+	Space  string  // Space hues are rotated in (oklch, lch), oklch if empty
+	Angle  float64 // Hue spread for split-complementary, analogous and tetradic, the scheme's default if 0
+	Count  int     // Number of colors for analogous and monochromatic, the scheme's default if 0
+	Gamut  string  // RGB space to keep colors inside, such as sRGB; no mapping if empty
+	Name   string  // Palette name, derived from the scheme and base if empty
+}
+
+// GenerateHarmony builds a harmony palette from a base color.
+func GenerateHarmony(opts GenerateOptions) (*palette.Palette, error) {
+	base, err := color.Parse(opts.Base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base color: %w", err)
+	}
+
+	harmony, err := palette.ParseHarmony(opts.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	harmonyOpts := palette.HarmonyOptions{Angle: opts.Angle, Count: opts.Count}
+	switch strings.ToLower(opts.Space) {
+	case "", "oklch":
+	case "lch":
+		harmonyOpts.CIELCh = true
+	default:
+		return nil, fmt.Errorf("invalid harmony space: %s (must be one of: oklch, lch)", opts.Space)
+	}
+
+	if opts.Gamut != "" {
+		space, ok := color.LookupRGBSpace(opts.Gamut)
+		if !ok {
+			return nil, fmt.Errorf("invalid gamut: %s", opts.Gamut)
+		}
+		harmonyOpts.Gamut = space
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = fmt.Sprintf("%s %s", harmony, opts.Base)
+	}
+
+	return palette.NewHarmony(name, base, harmony, harmonyOpts)
+}
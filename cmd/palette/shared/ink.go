@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kennyp/palette/color"
+	"github.com/kennyp/palette/palette"
+)
+
+// InkReport is the JSON form of a palette's ink coverage.
+type InkReport struct {
+	Palette         string                `json:"palette"`
+	BlackGeneration string                `json:"black_generation"`
+	InkLimit        float64               `json:"ink_limit,omitempty"` // Total area coverage limit in percent
+	Colors          []palette.InkCoverage `json:"colors"`
+}
+
+// ParseSeparation returns the CMYK separation for a black generation name (maximum if
+// empty) and an ink limit in percent (none if 0).
+func ParseSeparation(blackGeneration string, inkLimit float64) (color.Separation, error) {
+	var sep color.Separation
+	if blackGeneration != "" {
+		var err error
+		if sep.BlackGeneration, err = color.ParseBlackGeneration(blackGeneration); err != nil {
+			return color.Separation{}, err
+		}
+	}
+	if inkLimit < 0 || inkLimit > 400 {
+		return color.Separation{}, fmt.Errorf("invalid ink limit: %g (must be between 0 and 400)", inkLimit)
+	}
+	sep.InkLimit = inkLimit
+	return sep, nil
+}
+
+// WriteInkReport writes the CMYK ink coverage of every color in p to w, separating
+// colors that are not already CMYK with sep. format is "table" (the default) or "json".
+func WriteInkReport(w io.Writer, p *palette.Palette, format string, sep color.Separation) error {
+	coverage := p.InkCoverage(sep)
+
+	switch strings.ToLower(format) {
+	case "", "table":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "Color\tC\tM\tY\tK\tTotal")
+		for i, c := range coverage {
+			fmt.Fprintf(tw, "%s\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f", colorLabel(i, p.Colors[i]), c.C, c.M, c.Y, c.K, c.Total)
+			if c.OverLimit {
+				fmt.Fprintf(tw, "\tover %g%% limit", sep.InkLimit)
+			}
+			fmt.Fprintln(tw)
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("failed to write ink report: %w", err)
+		}
+		return nil
+
+	case "json":
+		report := InkReport{
+			Palette:         p.Name,
+			BlackGeneration: strings.ToLower(sep.BlackGeneration.String()),
+			InkLimit:        sep.InkLimit,
+			Colors:          coverage,
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("failed to write ink report: %w", err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("invalid report format: %s (must be one of: table, json)", format)
+	}
+}
@@ -0,0 +1,102 @@
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kennyp/palette/cmd/palette/shared"
+	"github.com/kennyp/palette/color"
+	"github.com/urfave/cli/v3"
+)
+
+// Command returns the simulate subcommand.
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:  "simulate",
+		Usage: "Export a copy of a palette as seen with a color vision deficiency",
+		Description: `Simulate how a palette appears to someone with a color vision
+deficiency and export the simulated copy. Color names are kept.
+
+Deficiencies:
+   protan        - red cones (protanopia at severity 1, protanomaly below)
+   deutan        - green cones (deuteranopia at severity 1, deuteranomaly below)
+   tritan        - blue cones (tritanopia at severity 1, tritanomaly below)
+   achromatopsia - no color vision
+
+Examples:
+   palette simulate -i brand.json -o brand-deutan.json --deficiency deutan
+   palette simulate -i brand.aco -o brand-protanomaly.aco --deficiency protan --severity 0.6`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "input",
+				Aliases:  []string{"i"},
+				Usage:    "Input file path (required)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Usage:    "Output file path (required)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "Source format (auto-detect if omitted): .acb, .aco, .csv, .json",
+			},
+			&cli.StringFlag{
+				Name:  "auto-name",
+				Usage: "Name unnamed colors after the nearest named color in a set: css, x11, extended",
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Usage: "Target format (infer from output extension if omitted): .acb, .aco, .csv, .json",
+			},
+			&cli.StringFlag{
+				Name:     "deficiency",
+				Aliases:  []string{"d"},
+				Usage:    "Color vision deficiency: protan, deutan, tritan, achromatopsia (required)",
+				Required: true,
+			},
+			&cli.FloatFlag{
+				Name:  "severity",
+				Usage: "Severity from 0 (normal vision) to 1 (complete deficiency)",
+				Value: 1,
+			},
+		},
+		Action: run,
+	}
+}
+
+func run(ctx context.Context, cmd *cli.Command) error {
+	inputPath := cmd.String("input")
+	outputPath := cmd.String("output")
+	severity := cmd.Float("severity")
+
+	deficiency, err := color.ParseDeficiency(cmd.String("deficiency"))
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+	if severity < 0 || severity > 1 {
+		return cli.Exit(fmt.Sprintf("Error: invalid severity: %g (must be between 0 and 1)", severity), 1)
+	}
+
+	// Check if input file exists
+	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+		return cli.Exit(fmt.Sprintf("Error: input file does not exist: %s", inputPath), 1)
+	}
+
+	p, err := shared.ImportFileAutoNamed(inputPath, cmd.String("from"), cmd.String("auto-name"))
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	if err := shared.ExportFile(p.SimulateCVD(deficiency, severity), outputPath, cmd.String("to")); err != nil {
+		return cli.Exit(fmt.Sprintf("Error: %v", err), 1)
+	}
+
+	fmt.Fprintf(cmd.Root().Writer, "Simulated %s at severity %g for %d colors\n", deficiency, severity, p.Len())
+	fmt.Fprintf(cmd.Root().Writer, "Output written to: %s\n", outputPath)
+
+	return nil
+}
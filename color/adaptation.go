@@ -0,0 +1,97 @@
+package color
+
+// CIE standard illuminant white points (2° observer), normalized to Y = 1.0.
+var (
+	// D50 is CIE standard illuminant D50 (horizon light), the ICC profile connection space white.
+	D50 = XYZ{X: 0.96422, Y: 1.00000, Z: 0.82521}
+
+	// D55 is CIE standard illuminant D55 (mid-morning daylight).
+	D55 = XYZ{X: 0.95682, Y: 1.00000, Z: 0.92149}
+
+	// D65 is CIE standard illuminant D65 (noon daylight), the sRGB white.
+	D65 = XYZ{X: 0.95047, Y: 1.00000, Z: 1.08883}
+
+	// D75 is CIE standard illuminant D75 (north sky daylight).
+	D75 = XYZ{X: 0.94972, Y: 1.00000, Z: 1.22638}
+)
+
+// whitePointName returns the short name of a standard white point, or "" if unknown.
+func whitePointName(white XYZ) string {
+	switch white {
+	case D50:
+		return "D50"
+	case D55:
+		return "D55"
+	case D65:
+		return "D65"
+	case D75:
+		return "D75"
+	}
+	return ""
+}
+
+//go:generate go tool stringer -type=Adaptation -trimprefix=Adaptation
+type Adaptation int // Chromatic adaptation transform
+
+const (
+	AdaptationBradford   Adaptation = iota // Bradford transform, as used by ICC profiles and Adobe applications
+	AdaptationCAT02                        // CIECAM02 transform
+	AdaptationVonKries                     // von Kries transform with Hunt-Pointer-Estevez cone responses
+	AdaptationXYZScaling                   // Simple scaling of XYZ values
+)
+
+// coneResponse returns the matrix that maps XYZ to the cone response domain of the transform.
+func (a Adaptation) coneResponse() mat3 {
+	switch a {
+	case AdaptationCAT02:
+		return mat3{
+			{0.7328, 0.4296, -0.1624},
+			{-0.7036, 1.6975, 0.0061},
+			{0.0030, 0.0136, 0.9834},
+		}
+	case AdaptationVonKries:
+		return mat3{
+			{0.40024, 0.70760, -0.08081},
+			{-0.22630, 1.16532, 0.04570},
+			{0.00000, 0.00000, 0.91822},
+		}
+	case AdaptationXYZScaling:
+		return mat3{
+			{1, 0, 0},
+			{0, 1, 0},
+			{0, 0, 1},
+		}
+	default:
+		return mat3{
+			{0.8951, 0.2664, -0.1614},
+			{-0.7502, 1.7135, 0.0367},
+			{0.0389, -0.0685, 1.0296},
+		}
+	}
+}
+
+// matrix returns the matrix that adapts XYZ values from one white point to another.
+func (a Adaptation) matrix(from, to XYZ) mat3 {
+	m := a.coneResponse()
+
+	fromL, fromM, fromS := m.apply(from.X, from.Y, from.Z)
+	toL, toM, toS := m.apply(to.X, to.Y, to.Z)
+
+	scale := mat3{
+		{toL / fromL, 0, 0},
+		{0, toM / fromM, 0},
+		{0, 0, toS / fromS},
+	}
+
+	return m.inverse().mul(scale).mul(m)
+}
+
+// Adapt converts XYZ values seen under the from white point to the corresponding
+// values under the to white point using the given chromatic adaptation transform.
+func (c XYZ) Adapt(from, to XYZ, method Adaptation) XYZ {
+	if from == to {
+		return c
+	}
+	x, y, z := method.matrix(from, to).apply(c.X, c.Y, c.Z)
+	return XYZ{X: x, Y: y, Z: z}
+}
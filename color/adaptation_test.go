@@ -0,0 +1,116 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdapt(t *testing.T) {
+	// sRGB red (D65) adapted to D50, reference values from Bruce Lindbloom
+	red := XYZ{X: 0.4124564, Y: 0.2126729, Z: 0.0193339}
+
+	tests := map[string]struct {
+		method Adaptation
+		want   XYZ
+	}{
+		"Bradford":   {AdaptationBradford, XYZ{0.4360747, 0.2225045, 0.0139322}},
+		"VonKries":   {AdaptationVonKries, XYZ{0.4298152, 0.2142084, 0.0146807}},
+		"XYZScaling": {AdaptationXYZScaling, XYZ{0.4184417, 0.2126729, 0.0146531}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := red.Adapt(D65, D50, tt.method)
+			const tolerance = 1e-4
+			if math.Abs(got.X-tt.want.X) > tolerance ||
+				math.Abs(got.Y-tt.want.Y) > tolerance ||
+				math.Abs(got.Z-tt.want.Z) > tolerance {
+				t.Errorf("Adapt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdaptWhitePoint(t *testing.T) {
+	// Every transform must map the source white exactly onto the destination white
+	for _, method := range []Adaptation{AdaptationBradford, AdaptationCAT02, AdaptationVonKries, AdaptationXYZScaling} {
+		t.Run(method.String(), func(t *testing.T) {
+			got := D65.Adapt(D65, D50, method)
+			const tolerance = 1e-12
+			if math.Abs(got.X-D50.X) > tolerance ||
+				math.Abs(got.Y-D50.Y) > tolerance ||
+				math.Abs(got.Z-D50.Z) > tolerance {
+				t.Errorf("Adapt(D65 white) = %v, want %v", got, D50)
+			}
+
+			back := got.Adapt(D50, D65, method)
+			if math.Abs(back.X-D65.X) > tolerance ||
+				math.Abs(back.Y-D65.Y) > tolerance ||
+				math.Abs(back.Z-D65.Z) > tolerance {
+				t.Errorf("Adapt() round trip = %v, want %v", back, D65)
+			}
+		})
+	}
+}
+
+func TestLAB64WhitePoint(t *testing.T) {
+	tests := map[string]struct {
+		color LAB64
+		want  XYZ
+	}{
+		"Zero value":     {LAB64{L: 50}, D65},
+		"NewLAB64":       {NewLAB64(50, 0, 0), D65},
+		"Explicit D65":   {NewLAB64WithWhite(50, 0, 0, D65), D65},
+		"Explicit D50":   {NewLAB64WithWhite(50, 0, 0, D50), D50},
+		"Adapted to D50": {NewLAB64(50, 10, 10).Adapt(D50, AdaptationBradford), D50},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.color.WhitePoint(); got != tt.want {
+				t.Errorf("WhitePoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLAB64D50(t *testing.T) {
+	// D50 Lab for sRGB red, as Photoshop reports it
+	c := NewLAB64WithWhite(54.29, 80.81, 69.89, D50)
+
+	if got, want := c.String(), "LAB(54.29, 80.81, 69.89, D50)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got, want := c.ToRGB(), (RGB{255, 0, 0}); got != want {
+		t.Errorf("ToRGB() = %v, want %v", got, want)
+	}
+	if got := c.ToLAB64().WhitePoint(); got != D65 {
+		t.Errorf("ToLAB64() white point = %v, want D65", got)
+	}
+
+	// Adapting to D65 and back must not drift
+	back := c.ToLAB64().Adapt(D50, AdaptationBradford)
+	const tolerance = 1e-9
+	if math.Abs(back.L-c.L) > tolerance ||
+		math.Abs(back.A-c.A) > tolerance ||
+		math.Abs(back.B-c.B) > tolerance {
+		t.Errorf("D50 -> D65 -> D50 drifted: %v -> %v", c, back)
+	}
+}
+
+func TestLAB64WithWhite(t *testing.T) {
+	// XYZ relative to D50 keeps its values, unlike ToLAB64 which takes D65 XYZ
+	c := XYZ{X: 0.4, Y: 0.3, Z: 0.2}
+	lab := c.ToLAB64WithWhite(D50)
+	if lab.WhitePoint() != D50 {
+		t.Errorf("ToLAB64WithWhite() white point = %v, want D50", lab.WhitePoint())
+	}
+	if got, want := D50.ToLAB64WithWhite(D50), (LAB64{L: 100, White: D50}); math.Abs(got.L-want.L) > 1e-9 || math.Abs(got.A) > 1e-9 || math.Abs(got.B) > 1e-9 {
+		t.Errorf("ToLAB64WithWhite() of the white = %v, want %v", got, want)
+	}
+
+	back := lab.RelativeXYZ()
+	if math.Abs(back.X-c.X) > 1e-12 || math.Abs(back.Y-c.Y) > 1e-12 || math.Abs(back.Z-c.Z) > 1e-12 {
+		t.Errorf("RelativeXYZ() = %v, want %v", back, c)
+	}
+}
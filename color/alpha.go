@@ -0,0 +1,39 @@
+package color
+
+import "fmt"
+
+// AlphaColor is a color with an opacity. It embeds the underlying color, so
+// every conversion behaves as for the opaque color and discards the alpha.
+type AlphaColor struct {
+	Color
+	// Alpha is the opacity, from 0.0 (transparent) to 1.0 (opaque).
+	Alpha float64
+}
+
+// WithAlpha returns c with the given opacity, replacing any alpha it already has.
+func WithAlpha(c Color, alpha float64) AlphaColor {
+	return AlphaColor{
+		Color: Opaque(c),
+		Alpha: clamp(alpha, 0, 1),
+	}
+}
+
+// Opaque returns c without its alpha channel.
+func Opaque(c Color) Color {
+	if ac, ok := c.(AlphaColor); ok {
+		return ac.Color
+	}
+	return c
+}
+
+// AlphaOf returns the opacity of c, which is 1.0 for colors without an alpha channel.
+func AlphaOf(c Color) float64 {
+	if ac, ok := c.(AlphaColor); ok {
+		return ac.Alpha
+	}
+	return 1
+}
+
+func (c AlphaColor) String() string {
+	return fmt.Sprintf("%s / %.2f%%", c.Color.String(), c.Alpha*100)
+}
@@ -0,0 +1,53 @@
+package color
+
+import "testing"
+
+func TestWithAlpha(t *testing.T) {
+	tests := map[string]struct {
+		color     Color
+		alpha     float64
+		wantAlpha float64
+		wantColor Color
+	}{
+		"Half":         {NewRGB(255, 0, 0), 0.5, 0.5, NewRGB(255, 0, 0)},
+		"Over range":   {NewRGB(255, 0, 0), 1.5, 1, NewRGB(255, 0, 0)},
+		"Under range":  {NewRGB(255, 0, 0), -1, 0, NewRGB(255, 0, 0)},
+		"Replace":      {WithAlpha(NewGray(0.5), 0.25), 0.75, 0.75, NewGray(0.5)},
+		"Other spaces": {NewOKLCH(0.7, 0.1, 250), 0.1, 0.1, NewOKLCH(0.7, 0.1, 250)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := WithAlpha(tt.color, tt.alpha)
+			if got := AlphaOf(c); got != tt.wantAlpha {
+				t.Errorf("AlphaOf() = %v, want %v", got, tt.wantAlpha)
+			}
+			if got := Opaque(c); got != tt.wantColor {
+				t.Errorf("Opaque() = %v, want %v", got, tt.wantColor)
+			}
+			if got, want := c.ColorSpace(), tt.wantColor.ColorSpace(); got != want {
+				t.Errorf("ColorSpace() = %v, want %v", got, want)
+			}
+			if got, want := c.ToRGB(), tt.wantColor.ToRGB(); got != want {
+				t.Errorf("ToRGB() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAlphaOfOpaque(t *testing.T) {
+	c := NewRGB(1, 2, 3)
+	if got := AlphaOf(c); got != 1 {
+		t.Errorf("AlphaOf() = %v, want 1", got)
+	}
+	if got := Opaque(c); got != c {
+		t.Errorf("Opaque() = %v, want %v", got, c)
+	}
+}
+
+func TestAlphaColorString(t *testing.T) {
+	c := WithAlpha(NewRGB(255, 0, 0), 0.5)
+	if got, want := c.String(), "RGB(255, 0, 0) / 50.00%"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+package color
+
+import (
+	"math"
+	"sync"
+)
+
+const (
+	// CCTMaxDuv is the largest distance from the Planckian locus, in CIE 1960 uv, at
+	// which a correlated color temperature is still meaningful.
+	CCTMaxDuv = 0.05
+
+	minKelvin = 1000   // Lowest color temperature handled, in K
+	maxKelvin = 100000 // Highest color temperature handled, in K
+
+	// planckC2 is Planck's second radiation constant, in m·K.
+	planckC2 = 1.4388e-2
+)
+
+// planckianLocus is a table of Planckian locus points from minKelvin to maxKelvin in
+// 1% steps, used to find the starting point of CCT searches.
+var planckianLocus = sync.OnceValue(func() []locusPoint {
+	var locus []locusPoint
+	for k := float64(minKelvin); k < maxKelvin*1.01; k *= 1.01 {
+		k = min(k, maxKelvin)
+		u, v := planckian(k).uv()
+		locus = append(locus, locusPoint{k, u, v})
+	}
+	return locus
+})
+
+// locusPoint is a point on the Planckian locus in CIE 1960 uv.
+type locusPoint struct {
+	kelvin, u, v float64
+}
+
+// FromKelvin returns the white of a light source with color temperature k, normalized
+// to Y = 1. Following the CIE reference illuminants for color rendering, it lies on
+// the Planckian (blackbody) locus below 5000 K and on the CIE daylight locus from
+// 5000 K to 25000 K, so FromKelvin(6504) is D65 and FromKelvin(5003) is D50. Above
+// 25000 K it returns to the Planckian locus. k is limited to 1000-100000 K.
+//
+// The result can be used as a white point, such as with XYZ.Adapt, or converted to
+// another color space; warm whites are outside the sRGB gamut at Y = 1.
+func FromKelvin(k float64) XYZ {
+	k = clamp(k, minKelvin, maxKelvin)
+	if k < 5000 || k > 25000 {
+		return planckian(k)
+	}
+	return daylight(k)
+}
+
+// CCT returns the correlated color temperature of c in kelvin, the temperature of the
+// nearest point on the Planckian locus in the CIE 1960 uv diagram, and Duv, the signed
+// distance to that point. Duv is positive above the locus (greenish) and negative
+// below it (pinkish).
+//
+// A CCT only describes the color when |Duv| is at most CCTMaxDuv; saturated colors
+// give arbitrary temperatures. Results are limited to 1000-100000 K. Black and other
+// colors without luminance or chromaticity have no CCT, and ok is false.
+func CCT(c Color) (cct, duv float64, ok bool) {
+	xyz := c.ToXYZ()
+	if xyz.Y <= 0 || xyz.X+15*xyz.Y+3*xyz.Z <= 0 {
+		return 0, 0, false
+	}
+	u, v := xyz.uv()
+	if math.IsNaN(u) || math.IsNaN(v) || math.IsInf(u, 0) || math.IsInf(v, 0) {
+		return 0, 0, false
+	}
+
+	distance := func(k float64) float64 {
+		lu, lv := planckian(k).uv()
+		return math.Hypot(u-lu, v-lv)
+	}
+
+	// Start from the nearest tabulated point, then refine between its neighbors
+	locus := planckianLocus()
+	nearest := 0
+	for i, p := range locus {
+		if math.Hypot(u-p.u, v-p.v) < math.Hypot(u-locus[nearest].u, v-locus[nearest].v) {
+			nearest = i
+		}
+	}
+	lo := locus[max(nearest-1, 0)].kelvin
+	hi := locus[min(nearest+1, len(locus)-1)].kelvin
+
+	// Golden-section search for the closest point on the locus
+	const ratio = 0.6180339887498949
+	a, b := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+	da, db := distance(a), distance(b)
+	for hi-lo > 1e-4 {
+		if da < db {
+			hi, b, db = b, a, da
+			a = hi - ratio*(hi-lo)
+			da = distance(a)
+		} else {
+			lo, a, da = a, b, db
+			b = lo + ratio*(hi-lo)
+			db = distance(b)
+		}
+	}
+
+	cct = (lo + hi) / 2
+	_, lv := planckian(cct).uv()
+	duv = distance(cct)
+	if v < lv {
+		duv = -duv
+	}
+	return cct, duv, true
+}
+
+// planckian returns the white of a blackbody radiator at temperature k, normalized to
+// Y = 1, integrating Planck's law against the CIE 1931 2° observer.
+func planckian(k float64) XYZ {
+	var spd [len(cie1931)]float64
+	for i := range spd {
+		wavelength := float64(cieStart+i*cieStep) * 1e-9
+		spd[i] = 1 / (math.Pow(wavelength, 5) * (math.Exp(planckC2/(wavelength*k)) - 1))
+	}
+	return spectrumToXYZ(spd[:]).normalize()
+}
+
+// daylight returns the CIE daylight illuminant white at correlated color temperature
+// k, normalized to Y = 1. The daylight locus is defined from 4000 K to 25000 K.
+func daylight(k float64) XYZ {
+	x, y := daylightChromaticity(k)
+	return XYZ{X: x / y, Y: 1, Z: (1 - x - y) / y}
+}
+
+// daylightChromaticity returns the CIE 1931 xy chromaticity of the CIE daylight
+// illuminant at correlated color temperature k.
+func daylightChromaticity(k float64) (x, y float64) {
+	if k <= 7000 {
+		x = -4.6070e9/(k*k*k) + 2.9678e6/(k*k) + 0.09911e3/k + 0.244063
+	} else {
+		x = -2.0064e9/(k*k*k) + 1.9018e6/(k*k) + 0.24748e3/k + 0.237040
+	}
+	y = -3*x*x + 2.870*x - 0.275
+	return x, y
+}
+
+// spectrumToXYZ integrates a spectral power distribution sampled on the CIE table
+// wavelengths against the CIE 1931 2° observer.
+func spectrumToXYZ(spd []float64) XYZ {
+	var xyz XYZ
+	for i, power := range spd {
+		xyz.X += power * cie1931[i][0]
+		xyz.Y += power * cie1931[i][1]
+		xyz.Z += power * cie1931[i][2]
+	}
+	return xyz
+}
+
+// normalize scales c to Y = 1.
+func (c XYZ) normalize() XYZ {
+	return XYZ{X: c.X / c.Y, Y: 1, Z: c.Z / c.Y}
+}
+
+// uv returns the CIE 1960 UCS chromaticity of c.
+func (c XYZ) uv() (u, v float64) {
+	d := c.X + 15*c.Y + 3*c.Z
+	return 4 * c.X / d, 6 * c.Y / d
+}
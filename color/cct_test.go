@@ -0,0 +1,97 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFromKelvin(t *testing.T) {
+	tests := map[string]struct {
+		kelvin float64
+		want   XYZ
+		tol    float64
+	}{
+		"Illuminant A": {2856, XYZ{X: 1.09850, Y: 1, Z: 0.35585}, 0.002},
+		"D50":          {5003, D50, 0.002},
+		"D65":          {6504, D65, 0.001},
+		"Clamped low":  {100, planckian(1000), 1e-9},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FromKelvin(tt.kelvin)
+			if math.Abs(got.X-tt.want.X) > tt.tol || got.Y != 1 || math.Abs(got.Z-tt.want.Z) > tt.tol {
+				t.Errorf("FromKelvin(%g) = %v, want %v", tt.kelvin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCCT(t *testing.T) {
+	tests := map[string]struct {
+		color   Color
+		wantK   float64
+		wantDuv float64
+		tolK    float64
+	}{
+		"Candle":          {FromKelvin(1900), 1900, 0, 0.5},
+		"Warm white":      {FromKelvin(2700), 2700, 0, 0.5},
+		"Blue sky":        {FromKelvin(40000), 40000, 0, 5},
+		"D65":             {D65, 6504, 0.0032, 10},
+		"D50":             {D50, 5003, 0.0032, 10},
+		"sRGB white":      {NewRGB(255, 255, 255), 6504, 0.0032, 10},
+		"Scaled":          {NewXYZ(0.5*1.09850, 0.5, 0.5*0.35585), 2856, 0, 5},
+		"Warm paint":      {NewRGB(255, 200, 150), 3620, -0.0023, 10},
+		"Below range":     {NewRGB(255, 0, 0), 1000, -0.0066, 1},
+		"Not a white":     {NewRGB(0, 0, 255), 100000, -0.1608, 1},
+		"Through alpha":   {WithAlpha(NewRGB(255, 255, 255), 0.5), 6504, 0.0032, 10},
+		"Any color space": {NewLAB64(100, 0, 0), 6504, 0.0032, 10},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, duv, ok := CCT(tt.color)
+			if !ok || math.Abs(k-tt.wantK) > tt.tolK || math.Abs(duv-tt.wantDuv) > 0.0002 {
+				t.Errorf("CCT(%v) = %.1f K, Duv %.4f; want %.1f K, Duv %.4f", tt.color, k, duv, tt.wantK, tt.wantDuv)
+			}
+		})
+	}
+}
+
+func TestCCTUndefined(t *testing.T) {
+	tests := map[string]Color{
+		"Black":          NewRGB(0, 0, 0),
+		"Through alpha":  WithAlpha(NewRGB(0, 0, 0), 0.5),
+		"No luminance":   NewXYZ(0.1, 0, 0.1),
+		"Negative light": NewXYZ(-0.1, -0.1, -0.1),
+	}
+
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			if k, duv, ok := CCT(c); ok {
+				t.Errorf("CCT(%v) = %.1f K, Duv %.4f, true; want false", c, k, duv)
+			}
+		})
+	}
+}
+
+func TestCIETables(t *testing.T) {
+	n := (cieEnd-cieStart)/cieStep + 1
+	if len(cie1931) != n {
+		t.Errorf("cie1931 has %d samples, want %d", len(cie1931), n)
+	}
+	if len(daylightBasis) != n {
+		t.Errorf("daylightBasis has %d samples, want %d", len(daylightBasis), n)
+	}
+
+	// The equal-energy spectrum is white, x = y = 1/3
+	var spd [len(cie1931)]float64
+	for i := range spd {
+		spd[i] = 1
+	}
+	xyz := spectrumToXYZ(spd[:])
+	sum := xyz.X + xyz.Y + xyz.Z
+	if math.Abs(xyz.X/sum-1.0/3) > 1e-3 || math.Abs(xyz.Y/sum-1.0/3) > 1e-3 {
+		t.Errorf("equal-energy white = %v, want x = y = 1/3", xyz)
+	}
+}
@@ -0,0 +1,295 @@
+package color
+
+// CIE colorimetric tables, sampled every 5 nm from cieStart to cieEnd inclusive.
+const (
+	cieStart = 380 // First wavelength of the CIE tables, in nm
+	cieEnd   = 780 // Last wavelength of the CIE tables, in nm
+	cieStep  = 5   // Wavelength interval of the CIE tables, in nm
+)
+
+// cie1931 holds the CIE 1931 2° standard observer color matching functions x̄, ȳ and z̄.
+var cie1931 = [...][3]float64{
+	{0.001368, 0.000039, 0.006450}, // 380 nm
+	{0.002236, 0.000064, 0.010550},
+	{0.004243, 0.000120, 0.020050},
+	{0.007650, 0.000217, 0.036210},
+	{0.014310, 0.000396, 0.067850}, // 400 nm
+	{0.023190, 0.000640, 0.110200},
+	{0.043510, 0.001210, 0.207400},
+	{0.077630, 0.002180, 0.371300},
+	{0.134380, 0.004000, 0.645600},
+	{0.214770, 0.007300, 1.039050},
+	{0.283900, 0.011600, 1.385600},
+	{0.328500, 0.016840, 1.622960},
+	{0.348280, 0.023000, 1.747060},
+	{0.348060, 0.029800, 1.782600},
+	{0.336200, 0.038000, 1.772110}, // 450 nm
+	{0.318700, 0.048000, 1.744100},
+	{0.290800, 0.060000, 1.669200},
+	{0.251100, 0.073900, 1.528100},
+	{0.195360, 0.090980, 1.287640},
+	{0.142100, 0.112600, 1.041900},
+	{0.095640, 0.139020, 0.812950},
+	{0.057950, 0.169300, 0.616200},
+	{0.032010, 0.208020, 0.465180},
+	{0.014700, 0.258600, 0.353300},
+	{0.004900, 0.323000, 0.272000}, // 500 nm
+	{0.002400, 0.407300, 0.212300},
+	{0.009300, 0.503000, 0.158200},
+	{0.029100, 0.608200, 0.111700},
+	{0.063270, 0.710000, 0.078250},
+	{0.109600, 0.793200, 0.057250},
+	{0.165500, 0.862000, 0.042160},
+	{0.225750, 0.914850, 0.029840},
+	{0.290400, 0.954000, 0.020300},
+	{0.359700, 0.980300, 0.013400},
+	{0.433450, 0.994950, 0.008750}, // 550 nm
+	{0.512050, 1.000000, 0.005750},
+	{0.594500, 0.995000, 0.003900},
+	{0.678400, 0.978600, 0.002750},
+	{0.762100, 0.952000, 0.002100},
+	{0.842500, 0.915400, 0.001800},
+	{0.916300, 0.870000, 0.001650},
+	{0.978600, 0.816300, 0.001400},
+	{1.026300, 0.757000, 0.001100},
+	{1.056700, 0.694900, 0.001000},
+	{1.062200, 0.631000, 0.000800}, // 600 nm
+	{1.045600, 0.566800, 0.000600},
+	{1.002600, 0.503000, 0.000340},
+	{0.938400, 0.441200, 0.000240},
+	{0.854450, 0.381000, 0.000190},
+	{0.751400, 0.321000, 0.000100},
+	{0.642400, 0.265000, 0.000050},
+	{0.541900, 0.217000, 0.000030},
+	{0.447900, 0.175000, 0.000020},
+	{0.360800, 0.138200, 0.000010},
+	{0.283500, 0.107000, 0.000000}, // 650 nm
+	{0.218700, 0.081600, 0.000000},
+	{0.164900, 0.061000, 0.000000},
+	{0.121200, 0.044580, 0.000000},
+	{0.087400, 0.032000, 0.000000},
+	{0.063600, 0.023200, 0.000000},
+	{0.046770, 0.017000, 0.000000},
+	{0.032900, 0.011920, 0.000000},
+	{0.022700, 0.008210, 0.000000},
+	{0.015840, 0.005723, 0.000000},
+	{0.011359, 0.004102, 0.000000}, // 700 nm
+	{0.008111, 0.002929, 0.000000},
+	{0.005790, 0.002091, 0.000000},
+	{0.004109, 0.001484, 0.000000},
+	{0.002899, 0.001047, 0.000000},
+	{0.002049, 0.000740, 0.000000},
+	{0.001440, 0.000520, 0.000000},
+	{0.001000, 0.000361, 0.000000},
+	{0.000690, 0.000249, 0.000000},
+	{0.000476, 0.000172, 0.000000},
+	{0.000332, 0.000120, 0.000000}, // 750 nm
+	{0.000235, 0.000085, 0.000000},
+	{0.000166, 0.000060, 0.000000},
+	{0.000117, 0.000042, 0.000000},
+	{0.000083, 0.000030, 0.000000},
+	{0.000059, 0.000021, 0.000000},
+	{0.000042, 0.000015, 0.000000}, // 780 nm
+}
+
+// cie1964 holds the CIE 1964 10° supplementary standard observer color matching
+// functions x̄₁₀, ȳ₁₀ and z̄₁₀.
+var cie1964 = [...][3]float64{
+	{0.000160, 0.000017, 0.000705}, // 380 nm
+	{0.000662, 0.000072, 0.002928},
+	{0.002362, 0.000253, 0.010482},
+	{0.007242, 0.000769, 0.032344},
+	{0.019110, 0.002004, 0.086011}, // 400 nm
+	{0.043400, 0.004509, 0.197120},
+	{0.084736, 0.008756, 0.389366},
+	{0.140638, 0.014456, 0.656760},
+	{0.204492, 0.021391, 0.972542},
+	{0.264737, 0.029497, 1.282500},
+	{0.314679, 0.038676, 1.553480},
+	{0.357719, 0.049602, 1.798500},
+	{0.383734, 0.062077, 1.967280},
+	{0.386726, 0.074704, 2.027300},
+	{0.370702, 0.089456, 1.994800}, // 450 nm
+	{0.342957, 0.106256, 1.900700},
+	{0.302273, 0.128201, 1.745370},
+	{0.254085, 0.152761, 1.554900},
+	{0.195618, 0.185190, 1.317560},
+	{0.132349, 0.219940, 1.030200},
+	{0.080507, 0.253589, 0.772125},
+	{0.041072, 0.297665, 0.570060},
+	{0.016172, 0.339133, 0.415254},
+	{0.005132, 0.395379, 0.302356},
+	{0.003816, 0.460777, 0.218502}, // 500 nm
+	{0.015444, 0.531360, 0.159249},
+	{0.037465, 0.606741, 0.112044},
+	{0.071358, 0.685660, 0.082248},
+	{0.117749, 0.761757, 0.060709},
+	{0.172953, 0.823330, 0.043050},
+	{0.236491, 0.875211, 0.030451},
+	{0.304213, 0.923810, 0.020584},
+	{0.376772, 0.961988, 0.013676},
+	{0.451584, 0.982200, 0.007918},
+	{0.529826, 0.991761, 0.003988}, // 550 nm
+	{0.616053, 0.999110, 0.001091},
+	{0.705224, 0.997340, 0.000000},
+	{0.793832, 0.982380, 0.000000},
+	{0.878655, 0.955552, 0.000000},
+	{0.951162, 0.915175, 0.000000},
+	{1.014160, 0.868934, 0.000000},
+	{1.074300, 0.825623, 0.000000},
+	{1.118520, 0.777405, 0.000000},
+	{1.134300, 0.720353, 0.000000},
+	{1.123990, 0.658341, 0.000000}, // 600 nm
+	{1.089100, 0.593878, 0.000000},
+	{1.030480, 0.527963, 0.000000},
+	{0.950740, 0.461834, 0.000000},
+	{0.856297, 0.398057, 0.000000},
+	{0.754930, 0.339554, 0.000000},
+	{0.647467, 0.283493, 0.000000},
+	{0.535110, 0.228254, 0.000000},
+	{0.431567, 0.179828, 0.000000},
+	{0.343690, 0.140211, 0.000000},
+	{0.268329, 0.107633, 0.000000}, // 650 nm
+	{0.204300, 0.081187, 0.000000},
+	{0.152568, 0.060281, 0.000000},
+	{0.112210, 0.044096, 0.000000},
+	{0.081261, 0.031800, 0.000000},
+	{0.057930, 0.022602, 0.000000},
+	{0.040851, 0.015905, 0.000000},
+	{0.028623, 0.011130, 0.000000},
+	{0.019941, 0.007749, 0.000000},
+	{0.013842, 0.005375, 0.000000},
+	{0.009577, 0.003718, 0.000000}, // 700 nm
+	{0.006605, 0.002565, 0.000000},
+	{0.004553, 0.001768, 0.000000},
+	{0.003145, 0.001222, 0.000000},
+	{0.002175, 0.000846, 0.000000},
+	{0.001506, 0.000586, 0.000000},
+	{0.001045, 0.000407, 0.000000},
+	{0.000727, 0.000284, 0.000000},
+	{0.000508, 0.000199, 0.000000},
+	{0.000356, 0.000140, 0.000000},
+	{0.000251, 0.000098, 0.000000}, // 750 nm
+	{0.000178, 0.000070, 0.000000},
+	{0.000126, 0.000050, 0.000000},
+	{0.000090, 0.000036, 0.000000},
+	{0.000065, 0.000025, 0.000000},
+	{0.000046, 0.000018, 0.000000},
+	{0.000033, 0.000013, 0.000000}, // 780 nm
+}
+
+// daylightBasis holds the CIE daylight components S0, S1 and S2, from which the
+// daylight illuminants (D50, D65 and so on) are built. The odd 5 nm values are
+// linearly interpolated from the 10 nm CIE table, as CIE 15 recommends.
+var daylightBasis = [...][3]float64{
+	{63.4, 38.5, 3.0}, // 380 nm
+	{64.6, 36.75, 2.1},
+	{65.8, 35.0, 1.2},
+	{80.3, 39.2, 0.05},
+	{94.8, 43.4, -1.1}, // 400 nm
+	{99.8, 44.85, -0.8},
+	{104.8, 46.3, -0.5},
+	{105.35, 45.1, -0.6},
+	{105.9, 43.9, -0.7},
+	{101.35, 40.5, -0.95},
+	{96.8, 37.1, -1.2},
+	{105.35, 36.9, -1.9},
+	{113.9, 36.7, -2.6},
+	{119.75, 36.3, -2.75},
+	{125.6, 35.9, -2.9}, // 450 nm
+	{125.55, 34.25, -2.85},
+	{125.5, 32.6, -2.8},
+	{123.4, 30.25, -2.7},
+	{121.3, 27.9, -2.6},
+	{121.3, 26.1, -2.6},
+	{121.3, 24.3, -2.6},
+	{117.4, 22.2, -2.2},
+	{113.5, 20.1, -1.8},
+	{113.3, 18.15, -1.65},
+	{113.1, 16.2, -1.5}, // 500 nm
+	{111.95, 14.7, -1.4},
+	{110.8, 13.2, -1.3},
+	{108.65, 10.9, -1.25},
+	{106.5, 8.6, -1.2},
+	{107.65, 7.35, -1.1},
+	{108.8, 6.1, -1.0},
+	{107.05, 5.15, -0.75},
+	{105.3, 4.2, -0.5},
+	{104.85, 3.05, -0.4},
+	{104.4, 1.9, -0.3}, // 550 nm
+	{102.2, 0.95, -0.15},
+	{100.0, 0.0, 0.0},
+	{98.0, -0.8, 0.1},
+	{96.0, -1.6, 0.2},
+	{95.55, -2.55, 0.35},
+	{95.1, -3.5, 0.5},
+	{92.1, -3.5, 1.3},
+	{89.1, -3.5, 2.1},
+	{89.8, -4.65, 2.65},
+	{90.5, -5.8, 3.2}, // 600 nm
+	{90.4, -6.5, 3.65},
+	{90.3, -7.2, 4.1},
+	{89.35, -7.9, 4.4},
+	{88.4, -8.6, 4.7},
+	{86.2, -9.05, 4.9},
+	{84.0, -9.5, 5.1},
+	{84.55, -10.2, 5.9},
+	{85.1, -10.9, 6.7},
+	{83.5, -10.8, 7.0},
+	{81.9, -10.7, 7.3}, // 650 nm
+	{82.25, -11.35, 7.95},
+	{82.6, -12.0, 8.6},
+	{83.75, -13.0, 9.2},
+	{84.9, -14.0, 9.8},
+	{83.1, -13.8, 10.0},
+	{81.3, -13.6, 10.2},
+	{76.6, -12.8, 9.25},
+	{71.9, -12.0, 8.3},
+	{73.1, -12.65, 8.95},
+	{74.3, -13.3, 9.6}, // 700 nm
+	{75.35, -13.1, 9.05},
+	{76.4, -12.9, 8.5},
+	{69.85, -11.75, 7.75},
+	{63.3, -10.6, 7.0},
+	{67.5, -11.1, 7.3},
+	{71.7, -11.6, 7.6},
+	{74.35, -11.9, 7.8},
+	{77.0, -12.2, 8.0},
+	{71.1, -11.2, 7.35},
+	{65.2, -10.2, 6.7}, // 750 nm
+	{56.45, -9.0, 5.95},
+	{47.7, -7.8, 5.2},
+	{58.15, -9.5, 6.3},
+	{68.6, -11.2, 7.4},
+	{66.8, -10.8, 7.1},
+	{65.0, -10.4, 6.8}, // 780 nm
+}
+
+// Relative spectral power of the CIE fluorescent illuminants.
+var (
+	// illuminantF2 is CIE F2, cool white fluorescent.
+	illuminantF2 = [...]float64{
+		1.18, 1.48, 1.84, 2.15, 3.44, 15.69, 3.85, 3.74, 4.19, 4.62, // 380 nm
+		5.06, 34.98, 11.81, 6.27, 6.63, 6.93, 7.19, 7.40, 7.54, 7.62, // 430 nm
+		7.65, 7.62, 7.62, 7.45, 7.28, 7.15, 7.05, 7.04, 7.16, 7.47, // 480 nm
+		8.04, 8.88, 10.01, 24.88, 16.64, 14.59, 16.16, 17.56, 18.62, 21.47, // 530 nm
+		22.79, 19.29, 18.66, 17.73, 16.54, 15.21, 13.80, 12.36, 10.95, 9.65, // 580 nm
+		8.40, 7.32, 6.31, 5.43, 4.68, 4.02, 3.45, 2.96, 2.55, 2.19, // 630 nm
+		1.89, 1.64, 1.53, 1.27, 1.10, 0.99, 0.88, 0.76, 0.68, 0.61, // 680 nm
+		0.56, 0.54, 0.51, 0.47, 0.47, 0.43, 0.46, 0.47, 0.40, 0.33, // 730 nm
+		0.27, // 780 nm
+	}
+
+	// illuminantF11 is CIE F11, narrow-band white fluorescent.
+	illuminantF11 = [...]float64{
+		0.91, 0.63, 0.46, 0.37, 1.29, 12.68, 1.59, 1.79, 2.46, 3.33, // 380 nm
+		4.49, 33.94, 12.13, 6.95, 7.19, 7.12, 6.72, 6.13, 5.46, 4.79, // 430 nm
+		5.66, 14.29, 14.96, 8.97, 4.72, 2.33, 1.47, 1.10, 0.89, 0.83, // 480 nm
+		1.18, 4.90, 39.59, 72.84, 32.61, 7.52, 2.83, 1.96, 1.67, 4.43, // 530 nm
+		11.28, 14.76, 12.73, 9.74, 7.33, 9.72, 55.27, 42.58, 13.18, 13.16, // 580 nm
+		12.26, 5.11, 2.07, 2.34, 3.58, 3.01, 2.48, 2.14, 1.54, 1.33, // 630 nm
+		1.46, 1.94, 2.00, 1.20, 1.35, 4.10, 5.58, 2.51, 0.57, 0.27, // 680 nm
+		0.23, 0.21, 0.24, 0.24, 0.20, 0.24, 0.32, 0.26, 0.16, 0.12, // 730 nm
+		0.09, // 780 nm
+	}
+)
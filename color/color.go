@@ -24,6 +24,21 @@ type Color interface {
 
 	// ToHSB converts the color to HSB color space.
 	ToHSB() HSB
+
+	// ToRGB64 converts the color to RGB color space without quantizing.
+	ToRGB64() RGB64
+
+	// ToCMYK64 converts the color to CMYK color space without quantizing.
+	ToCMYK64() CMYK64
+
+	// ToLAB64 converts the color to LAB color space without quantizing.
+	ToLAB64() LAB64
+
+	// ToHSB64 converts the color to HSB color space without quantizing.
+	ToHSB64() HSB64
+
+	// ToXYZ converts the color to CIE XYZ color space.
+	ToXYZ() XYZ
 }
 
 // RGB represents a color in RGB color space.
@@ -58,119 +73,39 @@ func (c RGB) ToRGB() RGB {
 }
 
 func (c RGB) ToCMYK() CMYK {
-	r := float64(c.R) / 255.0
-	g := float64(c.G) / 255.0
-	b := float64(c.B) / 255.0
-
-	k := 1.0 - math.Max(r, math.Max(g, b))
-	if k == 1.0 {
-		return CMYK{C: 0, M: 0, Y: 0, K: 100}
-	}
-
-	cy := (1.0 - r - k) / (1.0 - k)
-	mg := (1.0 - g - k) / (1.0 - k)
-	ye := (1.0 - b - k) / (1.0 - k)
-
-	return CMYK{
-		C: uint8(math.Round(cy * 100)),
-		M: uint8(math.Round(mg * 100)),
-		Y: uint8(math.Round(ye * 100)),
-		K: uint8(math.Round(k * 100)),
-	}
+	return c.ToCMYK64().ToCMYK()
 }
 
 func (c RGB) ToLAB() LAB {
-	// Convert RGB to XYZ first
-	r := float64(c.R) / 255.0
-	g := float64(c.G) / 255.0
-	b := float64(c.B) / 255.0
-
-	// Apply gamma correction
-	if r > 0.04045 {
-		r = math.Pow((r+0.055)/1.055, 2.4)
-	} else {
-		r = r / 12.92
-	}
+	return c.ToLAB64().ToLAB()
+}
 
-	if g > 0.04045 {
-		g = math.Pow((g+0.055)/1.055, 2.4)
-	} else {
-		g = g / 12.92
-	}
+func (c RGB) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
 
-	if b > 0.04045 {
-		b = math.Pow((b+0.055)/1.055, 2.4)
-	} else {
-		b = b / 12.92
+func (c RGB) ToRGB64() RGB64 {
+	return RGB64{
+		R: float64(c.R) / 255.0,
+		G: float64(c.G) / 255.0,
+		B: float64(c.B) / 255.0,
 	}
+}
 
-	// Convert to XYZ using sRGB matrix
-	x := r*0.4124564 + g*0.3575761 + b*0.1804375
-	y := r*0.2126729 + g*0.7151522 + b*0.0721750
-	z := r*0.0193339 + g*0.1191920 + b*0.9503041
-
-	// Convert XYZ to LAB
-	// D65 illuminant
-	xn := 0.95047
-	yn := 1.00000
-	zn := 1.08883
-
-	x = x / xn
-	y = y / yn
-	z = z / zn
-
-	fx := labF(x)
-	fy := labF(y)
-	fz := labF(z)
-
-	l := 116*fy - 16
-	a := 500 * (fx - fy)
-	b2 := 200 * (fy - fz)
+func (c RGB) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
 
-	return LAB{
-		L: int8(math.Round(clamp(l, 0, 100))),
-		A: int8(math.Round(clamp(a, -128, 127))),
-		B: int8(math.Round(clamp(b2, -128, 127))),
-	}
+func (c RGB) ToLAB64() LAB64 {
+	return c.ToRGB64().ToLAB64()
 }
 
-func (c RGB) ToHSB() HSB {
-	r := float64(c.R) / 255.0
-	g := float64(c.G) / 255.0
-	b := float64(c.B) / 255.0
-
-	max := math.Max(r, math.Max(g, b))
-	min := math.Min(r, math.Min(g, b))
-	delta := max - min
-
-	var h, s float64
-	brightness := max
-
-	if delta == 0 {
-		h = 0
-		s = 0
-	} else {
-		s = delta / max
-
-		switch max {
-		case r:
-			h = 60 * (math.Mod((g-b)/delta, 6))
-		case g:
-			h = 60 * ((b-r)/delta + 2)
-		case b:
-			h = 60 * ((r-g)/delta + 4)
-		}
-
-		if h < 0 {
-			h += 360
-		}
-	}
+func (c RGB) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
 
-	return HSB{
-		H: uint16(math.Round(h)),
-		S: uint8(math.Round(s * 100)),
-		B: uint8(math.Round(brightness * 100)),
-	}
+func (c RGB) ToXYZ() XYZ {
+	return c.ToRGB64().ToXYZ()
 }
 
 // CMYK represents a color in CMYK color space.
@@ -197,20 +132,7 @@ func (c CMYK) ColorSpace() string {
 }
 
 func (c CMYK) ToRGB() RGB {
-	cy := float64(c.C) / 100.0
-	mg := float64(c.M) / 100.0
-	ye := float64(c.Y) / 100.0
-	k := float64(c.K) / 100.0
-
-	r := 255 * (1 - cy) * (1 - k)
-	g := 255 * (1 - mg) * (1 - k)
-	b := 255 * (1 - ye) * (1 - k)
-
-	return RGB{
-		R: uint8(math.Round(r)),
-		G: uint8(math.Round(g)),
-		B: uint8(math.Round(b)),
-	}
+	return c.ToRGB64().ToRGB()
 }
 
 func (c CMYK) ToCMYK() CMYK {
@@ -218,11 +140,36 @@ func (c CMYK) ToCMYK() CMYK {
 }
 
 func (c CMYK) ToLAB() LAB {
-	return c.ToRGB().ToLAB()
+	return c.ToLAB64().ToLAB()
 }
 
 func (c CMYK) ToHSB() HSB {
-	return c.ToRGB().ToHSB()
+	return c.ToHSB64().ToHSB()
+}
+
+func (c CMYK) ToRGB64() RGB64 {
+	return c.ToCMYK64().ToRGB64()
+}
+
+func (c CMYK) ToCMYK64() CMYK64 {
+	return CMYK64{
+		C: float64(c.C) / 100.0,
+		M: float64(c.M) / 100.0,
+		Y: float64(c.Y) / 100.0,
+		K: float64(c.K) / 100.0,
+	}
+}
+
+func (c CMYK) ToLAB64() LAB64 {
+	return c.ToCMYK64().ToLAB64()
+}
+
+func (c CMYK) ToHSB64() HSB64 {
+	return c.ToCMYK64().ToHSB64()
+}
+
+func (c CMYK) ToXYZ() XYZ {
+	return c.ToCMYK64().ToXYZ()
 }
 
 // LAB represents a color in LAB color space.
@@ -249,61 +196,11 @@ func (c LAB) ColorSpace() string {
 }
 
 func (c LAB) ToRGB() RGB {
-	// Convert LAB to XYZ first
-	l := float64(c.L)
-	a := float64(c.A)
-	b := float64(c.B)
-
-	fy := (l + 16) / 116
-	fx := a/500 + fy
-	fz := fy - b/200
-
-	x := labFInv(fx)
-	y := labFInv(fy)
-	z := labFInv(fz)
-
-	// D65 illuminant
-	xn := 0.95047
-	yn := 1.00000
-	zn := 1.08883
-
-	x = x * xn
-	y = y * yn
-	z = z * zn
-
-	// Convert XYZ to RGB
-	r := x*3.2404542 + y*-1.5371385 + z*-0.4985314
-	g := x*-0.9692660 + y*1.8760108 + z*0.0415560
-	b2 := x*0.0556434 + y*-0.2040259 + z*1.0572252
-
-	// Apply gamma correction
-	if r > 0.0031308 {
-		r = 1.055*math.Pow(r, 1/2.4) - 0.055
-	} else {
-		r = 12.92 * r
-	}
-
-	if g > 0.0031308 {
-		g = 1.055*math.Pow(g, 1/2.4) - 0.055
-	} else {
-		g = 12.92 * g
-	}
-
-	if b2 > 0.0031308 {
-		b2 = 1.055*math.Pow(b2, 1/2.4) - 0.055
-	} else {
-		b2 = 12.92 * b2
-	}
-
-	return RGB{
-		R: uint8(math.Round(clamp(r, 0, 1) * 255)),
-		G: uint8(math.Round(clamp(g, 0, 1) * 255)),
-		B: uint8(math.Round(clamp(b2, 0, 1) * 255)),
-	}
+	return c.ToRGB64().ToRGB()
 }
 
 func (c LAB) ToCMYK() CMYK {
-	return c.ToRGB().ToCMYK()
+	return c.ToCMYK64().ToCMYK()
 }
 
 func (c LAB) ToLAB() LAB {
@@ -311,7 +208,27 @@ func (c LAB) ToLAB() LAB {
 }
 
 func (c LAB) ToHSB() HSB {
-	return c.ToRGB().ToHSB()
+	return c.ToHSB64().ToHSB()
+}
+
+func (c LAB) ToRGB64() RGB64 {
+	return c.ToLAB64().ToRGB64()
+}
+
+func (c LAB) ToCMYK64() CMYK64 {
+	return c.ToLAB64().ToCMYK64()
+}
+
+func (c LAB) ToLAB64() LAB64 {
+	return LAB64{L: float64(c.L), A: float64(c.A), B: float64(c.B)}
+}
+
+func (c LAB) ToHSB64() HSB64 {
+	return c.ToLAB64().ToHSB64()
+}
+
+func (c LAB) ToXYZ() XYZ {
+	return c.ToLAB64().ToXYZ()
 }
 
 // HSB represents a color in HSB (HSV) color space.
@@ -339,50 +256,45 @@ func (c HSB) ColorSpace() string {
 }
 
 func (c HSB) ToRGB() RGB {
-	h := float64(c.H)
-	s := float64(c.S) / 100.0
-	v := float64(c.B) / 100.0
-
-	chroma := v * s
-	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
-	m := v - chroma
-
-	var r, g, b float64
-
-	switch {
-	case h < 60:
-		r, g, b = chroma, x, 0
-	case h < 120:
-		r, g, b = x, chroma, 0
-	case h < 180:
-		r, g, b = 0, chroma, x
-	case h < 240:
-		r, g, b = 0, x, chroma
-	case h < 300:
-		r, g, b = x, 0, chroma
-	default:
-		r, g, b = chroma, 0, x
-	}
-
-	return RGB{
-		R: uint8(math.Round((r + m) * 255)),
-		G: uint8(math.Round((g + m) * 255)),
-		B: uint8(math.Round((b + m) * 255)),
-	}
+	return c.ToRGB64().ToRGB()
 }
 
 func (c HSB) ToCMYK() CMYK {
-	return c.ToRGB().ToCMYK()
+	return c.ToCMYK64().ToCMYK()
 }
 
 func (c HSB) ToLAB() LAB {
-	return c.ToRGB().ToLAB()
+	return c.ToLAB64().ToLAB()
 }
 
 func (c HSB) ToHSB() HSB {
 	return c
 }
 
+func (c HSB) ToRGB64() RGB64 {
+	return c.ToHSB64().ToRGB64()
+}
+
+func (c HSB) ToCMYK64() CMYK64 {
+	return c.ToHSB64().ToCMYK64()
+}
+
+func (c HSB) ToLAB64() LAB64 {
+	return c.ToHSB64().ToLAB64()
+}
+
+func (c HSB) ToHSB64() HSB64 {
+	return HSB64{
+		H: float64(c.H),
+		S: float64(c.S) / 100.0,
+		B: float64(c.B) / 100.0,
+	}
+}
+
+func (c HSB) ToXYZ() XYZ {
+	return c.ToHSB64().ToXYZ()
+}
+
 // Helper functions
 
 func clamp(value, min, max float64) float64 {
@@ -414,17 +326,3 @@ func clampInt8(value, min, max int8) int8 {
 	}
 	return value
 }
-
-func labF(t float64) float64 {
-	if t > 0.008856 {
-		return math.Pow(t, 1.0/3.0)
-	}
-	return 7.787*t + 16.0/116.0
-}
-
-func labFInv(t float64) float64 {
-	if t > 0.206893 {
-		return math.Pow(t, 3)
-	}
-	return (t - 16.0/116.0) / 7.787
-}
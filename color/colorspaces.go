@@ -0,0 +1,274 @@
+package color
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ColorSpace describes a color space that colors can be created in and converted to.
+// Register one with RegisterColorSpace to make it available by name to palette
+// conversion and the CLI.
+type ColorSpace struct {
+	// Name identifies the space and matches the ColorSpace method of its colors,
+	// e.g. "OKLCH"
+	Name string
+	// Aliases are other names the space can be looked up by, e.g. "HSV" for "HSB"
+	Aliases []string
+	// Description is a short human-readable description of the space
+	Description string
+	// Components names the components New takes, in order, e.g. L, C, H
+	Components []string
+	// New creates a color in the space from its components
+	New func(components ...float64) Color
+	// Convert converts a color from any space to this one
+	Convert func(c Color) Color
+	// Gamut is the RGB space bounding the colors of the space, or nil if it is unbounded
+	Gamut *RGBSpace
+}
+
+// Create creates a color in the space from its components, checking their number.
+func (s *ColorSpace) Create(components ...float64) (Color, error) {
+	if len(components) != len(s.Components) {
+		return nil, fmt.Errorf("%s colors have %d components (%s), got %d",
+			s.Name, len(s.Components), strings.Join(s.Components, ", "), len(components))
+	}
+	return s.New(components...), nil
+}
+
+// UnknownColorSpaceError is returned when a color space name is not registered.
+type UnknownColorSpaceError struct {
+	Name  string   // The name that was looked up
+	Known []string // Names of the registered spaces
+}
+
+func (e *UnknownColorSpaceError) Error() string {
+	return fmt.Sprintf("invalid color space: %s (must be one of: %s)", e.Name, strings.Join(e.Known, ", "))
+}
+
+// ColorSpaceRegistry maps names and aliases to color spaces.
+type ColorSpaceRegistry struct {
+	mu     sync.RWMutex
+	spaces []*ColorSpace
+	names  map[string]*ColorSpace
+}
+
+// NewColorSpaceRegistry creates a new empty registry.
+func NewColorSpaceRegistry() *ColorSpaceRegistry {
+	return &ColorSpaceRegistry{names: make(map[string]*ColorSpace)}
+}
+
+// Register adds a color space to the registry. It fails if the space has no name,
+// constructor or converter, or if its name or an alias is already taken.
+func (r *ColorSpaceRegistry) Register(space ColorSpace) error {
+	if space.Name == "" {
+		return fmt.Errorf("color space has no name")
+	}
+	if space.New == nil || space.Convert == nil {
+		return fmt.Errorf("color space %s has no constructor or converter", space.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := append([]string{space.Name}, space.Aliases...)
+	for _, name := range names {
+		if existing, ok := r.names[normalizeSpaceName(name)]; ok {
+			return fmt.Errorf("color space name %s is already taken by %s", name, existing.Name)
+		}
+	}
+
+	s := &space
+	r.spaces = append(r.spaces, s)
+	for _, name := range names {
+		r.names[normalizeSpaceName(name)] = s
+	}
+	return nil
+}
+
+// Lookup returns the color space with the given name or alias. Matching ignores case,
+// spaces, hyphens and underscores. Unknown names return an *UnknownColorSpaceError.
+func (r *ColorSpaceRegistry) Lookup(name string) (*ColorSpace, error) {
+	r.mu.RLock()
+	space, ok := r.names[normalizeSpaceName(name)]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, &UnknownColorSpaceError{Name: name, Known: r.Names()}
+	}
+	return space, nil
+}
+
+// Convert converts c to the named color space.
+func (r *ColorSpaceRegistry) Convert(c Color, name string) (Color, error) {
+	space, err := r.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return space.Convert(c), nil
+}
+
+// Spaces returns the registered color spaces in registration order.
+func (r *ColorSpaceRegistry) Spaces() []*ColorSpace {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	spaces := make([]*ColorSpace, len(r.spaces))
+	copy(spaces, r.spaces)
+	return spaces
+}
+
+// Names returns the names of the registered color spaces in registration order.
+func (r *ColorSpaceRegistry) Names() []string {
+	spaces := r.Spaces()
+	names := make([]string, len(spaces))
+	for i, s := range spaces {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// DefaultColorSpaces is the registry of color spaces used by the package-level
+// functions, holding the built-in spaces.
+var DefaultColorSpaces = NewColorSpaceRegistry()
+
+// RegisterColorSpace adds a color space to the default registry.
+func RegisterColorSpace(space ColorSpace) error {
+	return DefaultColorSpaces.Register(space)
+}
+
+// LookupColorSpace returns the color space with the given name or alias from the
+// default registry. Unknown names return an *UnknownColorSpaceError.
+func LookupColorSpace(name string) (*ColorSpace, error) {
+	return DefaultColorSpaces.Lookup(name)
+}
+
+// ConvertTo converts c to the named color space from the default registry.
+func ConvertTo(c Color, name string) (Color, error) {
+	return DefaultColorSpaces.Convert(c, name)
+}
+
+// ColorSpaces returns the color spaces of the default registry in registration order.
+func ColorSpaces() []*ColorSpace {
+	return DefaultColorSpaces.Spaces()
+}
+
+// ColorSpaceNames returns the names of the color spaces of the default registry.
+func ColorSpaceNames() []string {
+	return DefaultColorSpaces.Names()
+}
+
+// normalizeSpaceName folds case and drops separators, so "Display P3" and
+// "display-p3" are the same name.
+func normalizeSpaceName(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "", "(", "", ")", "", ".", "").Replace(strings.ToLower(name))
+}
+
+// Register the built-in color spaces
+func init() {
+	builtin := []ColorSpace{
+		{
+			Name:        "RGB",
+			Aliases:     []string{"sRGB"},
+			Description: "Standard RGB (IEC 61966-2-1)",
+			Components:  []string{"R", "G", "B"},
+			New:         func(v ...float64) Color { return NewRGB64(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToRGB64() },
+			Gamut:       SRGB,
+		},
+		{
+			Name:        "CMYK",
+			Description: "Process cyan, magenta, yellow and black",
+			Components:  []string{"C", "M", "Y", "K"},
+			New:         func(v ...float64) Color { return NewCMYK64(v[0], v[1], v[2], v[3]) },
+			Convert:     func(c Color) Color { return c.ToCMYK64() },
+			Gamut:       SRGB,
+		},
+		{
+			Name:        "LAB",
+			Aliases:     []string{"CIELAB", "L*a*b*"},
+			Description: "CIE 1976 L*a*b*",
+			Components:  []string{"L", "A", "B"},
+			New:         func(v ...float64) Color { return NewLAB64(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToLAB64() },
+		},
+		{
+			Name:        "HSB",
+			Aliases:     []string{"HSV"},
+			Description: "sRGB hue, saturation and brightness",
+			Components:  []string{"H", "S", "B"},
+			New:         func(v ...float64) Color { return NewHSB64(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToHSB64() },
+			Gamut:       SRGB,
+		},
+		{
+			Name:        "HSL",
+			Description: "sRGB hue, saturation and lightness",
+			Components:  []string{"H", "S", "L"},
+			New:         func(v ...float64) Color { return NewHSL(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToXYZ().ToHSL() },
+			Gamut:       SRGB,
+		},
+		{
+			Name:        "LCH",
+			Aliases:     []string{"CIELCh", "LCHab"},
+			Description: "CIE LCh, the polar form of L*a*b*",
+			Components:  []string{"L", "C", "H"},
+			New:         func(v ...float64) Color { return NewLCH(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToXYZ().ToLCH() },
+		},
+		{
+			Name:        "GRAY",
+			Aliases:     []string{"Grey", "Grayscale", "Greyscale"},
+			Description: "sRGB grayscale",
+			Components:  []string{"Y"},
+			New:         func(v ...float64) Color { return NewGray(v[0]) },
+			Convert:     func(c Color) Color { return c.ToXYZ().ToGray() },
+		},
+		{
+			Name:        "XYZ",
+			Aliases:     []string{"CIEXYZ"},
+			Description: "CIE 1931 XYZ relative to D65",
+			Components:  []string{"X", "Y", "Z"},
+			New:         func(v ...float64) Color { return NewXYZ(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToXYZ() },
+		},
+		{
+			Name:        "OKLAB",
+			Description: "Oklab perceptual color space",
+			Components:  []string{"L", "A", "B"},
+			New:         func(v ...float64) Color { return NewOKLab(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToXYZ().ToOKLab() },
+		},
+		{
+			Name:        "OKLCH",
+			Description: "OKLCH, the polar form of Oklab",
+			Components:  []string{"L", "C", "H"},
+			New:         func(v ...float64) Color { return NewOKLCH(v[0], v[1], v[2]) },
+			Convert:     func(c Color) Color { return c.ToXYZ().ToOKLCH() },
+		},
+		rgbColorSpace(DisplayP3, "Display P3", "P3"),
+		rgbColorSpace(AdobeRGB, "Adobe RGB (1998)", "AdobeRGB1998"),
+		rgbColorSpace(ProPhoto, "ProPhoto RGB, relative to D50", "ProPhotoRGB", "ROMMRGB"),
+		rgbColorSpace(Rec2020, "ITU-R BT.2020", "BT2020"),
+	}
+
+	for _, space := range builtin {
+		if err := RegisterColorSpace(space); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// rgbColorSpace describes an RGB working space as a color space.
+func rgbColorSpace(space *RGBSpace, description string, aliases ...string) ColorSpace {
+	return ColorSpace{
+		Name:        space.Name,
+		Aliases:     aliases,
+		Description: description,
+		Components:  []string{"R", "G", "B"},
+		New:         func(v ...float64) Color { return space.New(v[0], v[1], v[2]) },
+		Convert:     func(c Color) Color { return space.Convert(c) },
+		Gamut:       space,
+	}
+}
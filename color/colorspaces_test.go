@@ -0,0 +1,120 @@
+package color
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLookupColorSpace(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		want string
+	}{
+		"Name":            {"OKLCH", "OKLCH"},
+		"Lower case":      {"lab", "LAB"},
+		"Alias":           {"HSV", "HSB"},
+		"sRGB":            {"sRGB", "RGB"},
+		"Separators":      {"display-p3", "DisplayP3"},
+		"RGB space alias": {"Adobe RGB (1998)", "AdobeRGB"},
+		"Grey":            {"grey", "GRAY"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			space, err := LookupColorSpace(tt.name)
+			if err != nil {
+				t.Fatalf("LookupColorSpace(%q) error = %v", tt.name, err)
+			}
+			if space.Name != tt.want {
+				t.Errorf("LookupColorSpace(%q) = %s, want %s", tt.name, space.Name, tt.want)
+			}
+		})
+	}
+
+	for _, name := range []string{"", "INVALID", "SPECTRAL"} {
+		t.Run("Unknown "+name, func(t *testing.T) {
+			_, err := LookupColorSpace(name)
+			var unknown *UnknownColorSpaceError
+			if !errors.As(err, &unknown) {
+				t.Fatalf("LookupColorSpace(%q) error = %v, want *UnknownColorSpaceError", name, err)
+			}
+			if unknown.Name != name || len(unknown.Known) != len(ColorSpaces()) {
+				t.Errorf("UnknownColorSpaceError = %+v", unknown)
+			}
+		})
+	}
+}
+
+func TestColorSpaces(t *testing.T) {
+	red := NewRGB(255, 0, 0)
+
+	for _, space := range ColorSpaces() {
+		t.Run(space.Name, func(t *testing.T) {
+			converted := space.Convert(red)
+			if got := converted.ColorSpace(); got != space.Name {
+				t.Errorf("Convert() color space = %s, want %s", got, space.Name)
+			}
+
+			if space.Gamut != nil && converted.ToRGB() != red {
+				t.Errorf("Convert() = %v, want %v", converted.ToRGB(), red)
+			}
+
+			created, err := space.Create(make([]float64, len(space.Components))...)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if got := created.ColorSpace(); got != space.Name {
+				t.Errorf("Create() color space = %s, want %s", got, space.Name)
+			}
+
+			if _, err := space.Create(1); len(space.Components) != 1 && err == nil {
+				t.Errorf("Create() with one component succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestColorSpaceRegistry(t *testing.T) {
+	r := NewColorSpaceRegistry()
+	cmyk := ColorSpace{
+		Name:       "CMYK",
+		Aliases:    []string{"Process"},
+		Components: []string{"C", "M", "Y", "K"},
+		New:        func(v ...float64) Color { return NewCMYK64(v[0], v[1], v[2], v[3]) },
+		Convert:    func(c Color) Color { return c.ToCMYK64() },
+	}
+
+	if err := r.Register(cmyk); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	tests := map[string]ColorSpace{
+		"Taken name":     {Name: "cmyk", New: cmyk.New, Convert: cmyk.Convert},
+		"Taken alias":    {Name: "Other", Aliases: []string{"process"}, New: cmyk.New, Convert: cmyk.Convert},
+		"No name":        {New: cmyk.New, Convert: cmyk.Convert},
+		"No constructor": {Name: "Other", Convert: cmyk.Convert},
+		"No converter":   {Name: "Other", New: cmyk.New},
+	}
+	for name, space := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := r.Register(space); err == nil {
+				t.Errorf("Register(%+v) succeeded, want error", space)
+			}
+		})
+	}
+
+	converted, err := r.Convert(NewRGB(255, 0, 0), "process")
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if converted != (CMYK64{M: 1, Y: 1}) {
+		t.Errorf("Convert() = %v, want CMYK(0%%, 100%%, 100%%, 0%%)", converted)
+	}
+
+	if _, err := r.Convert(NewRGB(255, 0, 0), "RGB"); err == nil {
+		t.Errorf("Convert() to a space of another registry succeeded, want error")
+	}
+	if names := r.Names(); len(names) != 1 || names[0] != "CMYK" {
+		t.Errorf("Names() = %v, want [CMYK]", names)
+	}
+}
@@ -0,0 +1,102 @@
+package color
+
+import "math"
+
+// WCAG 2.1 minimum contrast ratios. Large text is at least 18pt, or 14pt bold.
+const (
+	ContrastAA       = 4.5 // Level AA for normal text
+	ContrastAALarge  = 3.0 // Level AA for large text and user interface components
+	ContrastAAA      = 7.0 // Level AAA for normal text
+	ContrastAAALarge = 4.5 // Level AAA for large text
+)
+
+// RelativeLuminance returns the WCAG 2.1 relative luminance of c, from 0 for black to 1
+// for white, computed from its sRGB value.
+func RelativeLuminance(c Color) float64 {
+	rgb := c.ToRGB64()
+	linear := func(v float64) float64 {
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(rgb.R) + 0.7152*linear(rgb.G) + 0.0722*linear(rgb.B)
+}
+
+// ContrastRatio returns the WCAG 2.1 contrast ratio of a foreground color on a
+// background, from 1 to 21. The ratio is symmetric except that a translucent
+// foreground is first blended over the background; the background's alpha is ignored.
+func ContrastRatio(foreground, background Color) float64 {
+	foreground = composite(foreground, background)
+	l1, l2 := RelativeLuminance(foreground), RelativeLuminance(background)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// APCA 0.0.98G-4g constants.
+const (
+	apcaBlackThreshold = 0.022
+	apcaBlackClamp     = 1.414
+	apcaNormBG         = 0.56
+	apcaNormText       = 0.57
+	apcaRevText        = 0.62
+	apcaRevBG          = 0.65
+	apcaScale          = 1.14
+	apcaOffset         = 0.027
+	apcaLowClip        = 0.1
+	apcaDeltaYMin      = 0.0005
+)
+
+// APCAContrast returns the APCA lightness contrast (Lc) of text on a background, using
+// the 0.0.98G-4g constants. Dark text on a light background gives a positive value up
+// to about 106, and light text on a dark background a negative value down to about
+// -108. Unlike the WCAG ratio, the order matters. A translucent foreground is first
+// blended over the background.
+func APCAContrast(text, background Color) float64 {
+	text = composite(text, background)
+	yText, yBG := apcaLuminance(text), apcaLuminance(background)
+
+	if math.Abs(yBG-yText) < apcaDeltaYMin {
+		return 0
+	}
+
+	var lc float64
+	if yBG > yText {
+		// Dark text on a light background
+		sapc := (math.Pow(yBG, apcaNormBG) - math.Pow(yText, apcaNormText)) * apcaScale
+		if sapc >= apcaLowClip {
+			lc = sapc - apcaOffset
+		}
+	} else {
+		// Light text on a dark background
+		sapc := (math.Pow(yBG, apcaRevBG) - math.Pow(yText, apcaRevText)) * apcaScale
+		if sapc <= -apcaLowClip {
+			lc = sapc + apcaOffset
+		}
+	}
+	return lc * 100
+}
+
+// apcaLuminance returns the APCA screen luminance of c, with the soft clamp for
+// near-black colors.
+func apcaLuminance(c Color) float64 {
+	rgb := c.ToRGB64()
+	y := 0.2126729*math.Pow(rgb.R, 2.4) + 0.7151522*math.Pow(rgb.G, 2.4) + 0.0721750*math.Pow(rgb.B, 2.4)
+	if y < apcaBlackThreshold {
+		y += math.Pow(apcaBlackThreshold-y, apcaBlackClamp)
+	}
+	return y
+}
+
+// composite blends a translucent foreground over an opaque background in sRGB, as a
+// browser would draw it. Opaque colors are returned as they are.
+func composite(foreground, background Color) Color {
+	alpha := AlphaOf(foreground)
+	if alpha >= 1 {
+		return foreground
+	}
+	f, b := foreground.ToRGB64(), background.ToRGB64()
+	return RGB64{R: lerp(b.R, f.R, alpha), G: lerp(b.G, f.G, alpha), B: lerp(b.B, f.B, alpha)}
+}
@@ -0,0 +1,78 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContrastRatio(t *testing.T) {
+	white, black := NewRGB(255, 255, 255), NewRGB(0, 0, 0)
+
+	tests := map[string]struct {
+		foreground, background Color
+		want                   float64
+	}{
+		"Black on white":       {black, white, 21},
+		"White on black":       {white, black, 21},
+		"Same color":           {NewRGB(120, 40, 200), NewRGB(120, 40, 200), 1},
+		"#767676 on white":     {NewRGB(0x76, 0x76, 0x76), white, 4.54},
+		"#777777 on white":     {NewRGB(0x77, 0x77, 0x77), white, 4.48},
+		"Red on white":         {NewRGB(255, 0, 0), white, 4.00},
+		"CMYK black on white":  {NewCMYK(0, 0, 0, 100), white, 21},
+		"Half black on white":  {WithAlpha(black, 0.5), white, 3.98}, // Drawn as 50% gray
+		"Transparent on white": {WithAlpha(black, 0), white, 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ContrastRatio(tt.foreground, tt.background); math.Abs(got-tt.want) > 0.005 {
+				t.Errorf("ContrastRatio() = %.4f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelativeLuminance(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  float64
+	}{
+		"Black": {NewRGB(0, 0, 0), 0},
+		"White": {NewRGB(255, 255, 255), 1},
+		"Red":   {NewRGB(255, 0, 0), 0.2126},
+		"Green": {NewRGB(0, 255, 0), 0.7152},
+		"Blue":  {NewRGB(0, 0, 255), 0.0722},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := RelativeLuminance(tt.color); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RelativeLuminance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPCAContrast(t *testing.T) {
+	// Reference values from the APCA 0.0.98G-4g test suite
+	tests := map[string]struct {
+		text, background Color
+		want             float64
+	}{
+		"Black on white":   {NewRGB(0, 0, 0), NewRGB(255, 255, 255), 106.04},
+		"White on black":   {NewRGB(255, 255, 255), NewRGB(0, 0, 0), -107.88},
+		"#888888 on white": {NewRGB(0x88, 0x88, 0x88), NewRGB(255, 255, 255), 63.06},
+		"White on #888888": {NewRGB(255, 255, 255), NewRGB(0x88, 0x88, 0x88), -68.54},
+		"Black on #aaaaaa": {NewRGB(0, 0, 0), NewRGB(0xaa, 0xaa, 0xaa), 58.15},
+		"#aaaaaa on black": {NewRGB(0xaa, 0xaa, 0xaa), NewRGB(0, 0, 0), -56.24},
+		"Same color":       {NewRGB(100, 150, 200), NewRGB(100, 150, 200), 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := APCAContrast(tt.text, tt.background); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("APCAContrast() = %.4f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package color
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Parse parses a CSS Color Level 4 color string: hex notation (#rgb, #rgba,
+// #rrggbb, #rrggbbaa), a named color, or one of the rgb(), hsl(), hwb(), lab(),
+// lch(), oklab(), oklch() and color() functions.
+//
+// Hex, named and whole-number rgb() colors are returned as RGB; other functions
+// return the matching color type. lab() and lch() are relative to D50, as in CSS.
+// Colors with an alpha component are wrapped in an AlphaColor.
+func Parse(s string) (Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return nil, fmt.Errorf("empty color")
+	}
+
+	if strings.HasPrefix(s, "#") {
+		return parseHex(s[1:])
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		if s == "transparent" {
+			return WithAlpha(NewRGB(0, 0, 0), 0), nil
+		}
+		if c, ok := cssNamedColors[s]; ok {
+			return c, nil
+		}
+		return nil, fmt.Errorf("unknown color name: %s", s)
+	}
+
+	if !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("invalid color %q: missing closing parenthesis", s)
+	}
+	fn := strings.TrimSpace(s[:open])
+	legacy := fn == "rgb" || fn == "rgba" || fn == "hsl" || fn == "hsla"
+	args, alpha, err := splitCSSArgs(s[open+1:len(s)-1], legacy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q: %w", s, err)
+	}
+
+	var c Color
+	switch fn {
+	case "rgb", "rgba":
+		c, err = parseCSSRGB(args)
+	case "hsl", "hsla":
+		c, err = parseCSSHSL(args)
+	case "hwb":
+		c, err = parseCSSHWB(args)
+	case "lab":
+		c, err = parseCSSLab(args)
+	case "lch":
+		c, err = parseCSSLCH(args)
+	case "oklab":
+		c, err = parseCSSOKLab(args)
+	case "oklch":
+		c, err = parseCSSOKLCH(args)
+	case "color":
+		c, err = parseCSSColorFunction(args)
+	default:
+		return nil, fmt.Errorf("unsupported color function: %s()", fn)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q: %w", s, err)
+	}
+
+	if alpha != "" {
+		a, err := parseCSSComponent(alpha, 1)
+		if err != nil {
+			return nil, fmt.Errorf("invalid color %q: alpha: %w", s, err)
+		}
+		return WithAlpha(c, a), nil
+	}
+	return c, nil
+}
+
+// parseHex parses the digits of a hex color.
+func parseHex(hex string) (Color, error) {
+	switch len(hex) {
+	case 3, 4:
+		// Each digit is doubled: #f80 is #ff8800
+		expanded := make([]byte, 0, len(hex)*2)
+		for i := range len(hex) {
+			expanded = append(expanded, hex[i], hex[i])
+		}
+		hex = string(expanded)
+	case 6, 8:
+	default:
+		return nil, fmt.Errorf("invalid hex color length: %d", len(hex))
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex color: #%s", hex)
+	}
+
+	if len(hex) == 8 {
+		c := NewRGB(uint8(v>>24), uint8(v>>16), uint8(v>>8))
+		return WithAlpha(c, float64(uint8(v))/255), nil
+	}
+	return NewRGB(uint8(v>>16), uint8(v>>8), uint8(v)), nil
+}
+
+// splitCSSArgs splits the arguments of a color function into its components and
+// alpha. The modern space-separated syntax ("255 0 0 / 50%") is always accepted, and
+// the legacy comma-separated syntax ("255, 0, 0, 0.5") only if legacy is set, as CSS
+// allows it in rgb() and hsl() alone.
+func splitCSSArgs(s string, legacy bool) (args []string, alpha string, err error) {
+	if strings.Contains(s, ",") {
+		if !legacy {
+			return nil, "", fmt.Errorf("comma-separated arguments are only allowed in rgb() and hsl()")
+		}
+		for arg := range strings.SplitSeq(s, ",") {
+			args = append(args, strings.TrimSpace(arg))
+		}
+		if len(args) == 4 {
+			return args[:3], args[3], nil
+		}
+		if len(args) != 3 {
+			return nil, "", fmt.Errorf("expected 3 or 4 components, got %d", len(args))
+		}
+		return args, "", nil
+	}
+
+	components, alpha, hasAlpha := strings.Cut(s, "/")
+	args = strings.Fields(components)
+	if hasAlpha {
+		alpha = strings.TrimSpace(alpha)
+		if alpha == "" || strings.ContainsAny(alpha, " /") {
+			return nil, "", fmt.Errorf("invalid alpha: %q", alpha)
+		}
+	}
+	return args, alpha, nil
+}
+
+// parseCSSComponent parses a number, a percentage of percentRef, or "none" (zero).
+func parseCSSComponent(s string, percentRef float64) (float64, error) {
+	if s == "none" {
+		return 0, nil
+	}
+	if v, ok := strings.CutSuffix(s, "%"); ok {
+		f, err := parseCSSNumber(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid percentage: %s", s)
+		}
+		return f / 100 * percentRef, nil
+	}
+	f, err := parseCSSNumber(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number: %s", s)
+	}
+	return f, nil
+}
+
+// parseCSSNumber parses a finite number. strconv.ParseFloat also accepts NaN and
+// infinities, which CSS numbers cannot be.
+func parseCSSNumber(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("not a finite number: %s", s)
+	}
+	return f, nil
+}
+
+// parseCSSHue parses a hue in degrees, or with a deg, grad, rad or turn unit.
+func parseCSSHue(s string) (float64, error) {
+	if s == "none" {
+		return 0, nil
+	}
+
+	units := []struct {
+		suffix  string
+		degrees float64
+	}{
+		{"deg", 1},
+		{"grad", 0.9},
+		{"rad", 180 / math.Pi},
+		{"turn", 360},
+	}
+	for _, unit := range units {
+		if v, ok := strings.CutSuffix(s, unit.suffix); ok {
+			f, err := parseCSSNumber(v)
+			if err != nil {
+				return 0, fmt.Errorf("invalid hue: %s", s)
+			}
+			return f * unit.degrees, nil
+		}
+	}
+
+	f, err := parseCSSNumber(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hue: %s", s)
+	}
+	return f, nil
+}
+
+// parseCSSComponents parses three components, each with its own percentage reference.
+// A zero reference marks a hue.
+func parseCSSComponents(args []string, refs [3]float64) ([3]float64, error) {
+	var v [3]float64
+	if len(args) != 3 {
+		return v, fmt.Errorf("expected 3 components, got %d", len(args))
+	}
+
+	for i, arg := range args {
+		var err error
+		if refs[i] == 0 {
+			v[i], err = parseCSSHue(arg)
+		} else {
+			v[i], err = parseCSSComponent(arg, refs[i])
+		}
+		if err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+func parseCSSRGB(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{255, 255, 255})
+	if err != nil {
+		return nil, err
+	}
+
+	if v[0] == math.Trunc(v[0]) && v[1] == math.Trunc(v[1]) && v[2] == math.Trunc(v[2]) {
+		return NewRGB(uint8(clamp(v[0], 0, 255)), uint8(clamp(v[1], 0, 255)), uint8(clamp(v[2], 0, 255))), nil
+	}
+	return NewRGB64(v[0]/255, v[1]/255, v[2]/255), nil
+}
+
+func parseCSSHSL(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{0, 100, 100})
+	if err != nil {
+		return nil, err
+	}
+	return NewHSL(v[0], v[1]/100, v[2]/100), nil
+}
+
+func parseCSSHWB(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{0, 100, 100})
+	if err != nil {
+		return nil, err
+	}
+
+	white := clamp(v[1]/100, 0, 1)
+	black := clamp(v[2]/100, 0, 1)
+	if white+black >= 1 {
+		gray := white / (white + black)
+		return NewRGB64(gray, gray, gray), nil
+	}
+
+	// Mix the pure hue with white and black
+	hue := NewHSL(v[0], 1, 0.5).ToRGB64()
+	scale := 1 - white - black
+	return NewRGB64(hue.R*scale+white, hue.G*scale+white, hue.B*scale+white), nil
+}
+
+func parseCSSLab(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{100, 125, 125})
+	if err != nil {
+		return nil, err
+	}
+	return NewLAB64WithWhite(v[0], v[1], v[2], D50), nil
+}
+
+func parseCSSLCH(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{100, 150, 0})
+	if err != nil {
+		return nil, err
+	}
+	return NewLCHWithWhite(v[0], v[1], v[2], D50), nil
+}
+
+func parseCSSOKLab(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{1, 0.4, 0.4})
+	if err != nil {
+		return nil, err
+	}
+	return NewOKLab(v[0], v[1], v[2]), nil
+}
+
+func parseCSSOKLCH(args []string) (Color, error) {
+	v, err := parseCSSComponents(args, [3]float64{1, 0.4, 0})
+	if err != nil {
+		return nil, err
+	}
+	return NewOKLCH(v[0], v[1], v[2]), nil
+}
+
+// parseCSSColorFunction parses the arguments of color(), which start with a
+// predefined color space name.
+func parseCSSColorFunction(args []string) (Color, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("missing color space")
+	}
+
+	space := args[0]
+	v, err := parseCSSComponents(args[1:], [3]float64{1, 1, 1})
+	if err != nil {
+		return nil, err
+	}
+
+	switch space {
+	case "srgb":
+		return NewRGB64(v[0], v[1], v[2]), nil
+	case "srgb-linear":
+		return NewRGB64(linearToSRGB(v[0]), linearToSRGB(v[1]), linearToSRGB(v[2])), nil
+	case "xyz", "xyz-d65":
+		return NewXYZ(v[0], v[1], v[2]), nil
+	case "xyz-d50":
+		return NewXYZ(v[0], v[1], v[2]).Adapt(D50, D65, AdaptationBradford), nil
+	}
+
+	for s, name := range cssSpaceNames {
+		if name == space {
+			return s.New(v[0], v[1], v[2]), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported color space: %s", space)
+}
+
+// cssSpaceNames maps RGB working spaces to their CSS predefined color space names.
+var cssSpaceNames = map[*RGBSpace]string{
+	SRGB:      "srgb",
+	DisplayP3: "display-p3",
+	AdobeRGB:  "a98-rgb",
+	ProPhoto:  "prophoto-rgb",
+	Rec2020:   "rec2020",
+}
+
+// FormatCSS formats a color as a CSS Color Level 4 string that Parse reads back.
+// HSL, LAB, LCH, OKLab, OKLCH, XYZ and the standard RGB working spaces keep their
+// own notation; every other color is written as rgb().
+func FormatCSS(c Color) string {
+	s := formatCSSOpaque(Opaque(c))
+	if ac, ok := c.(AlphaColor); ok {
+		s = fmt.Sprintf("%s / %s)", s[:len(s)-1], cssNumber(ac.Alpha, 3))
+	}
+	return s
+}
+
+func formatCSSOpaque(c Color) string {
+	switch c := c.(type) {
+	case RGB:
+		return fmt.Sprintf("rgb(%d %d %d)", c.R, c.G, c.B)
+
+	case HSL:
+		return fmt.Sprintf("hsl(%s %s%% %s%%)", cssNumber(c.H, 2), cssNumber(c.S*100, 2), cssNumber(c.L*100, 2))
+
+	case LAB:
+		return formatCSSLab(c.ToLAB64())
+
+	case LAB64:
+		return formatCSSLab(c)
+
+	case LCH:
+		lch := c.lab().Adapt(D50, AdaptationBradford).ToLCH()
+		return fmt.Sprintf("lch(%s %s %s)", cssNumber(lch.L, 2), cssNumber(lch.C, 2), cssNumber(lch.H, 2))
+
+	case OKLab:
+		return fmt.Sprintf("oklab(%s %s %s)", cssNumber(c.L, 4), cssNumber(c.A, 4), cssNumber(c.B, 4))
+
+	case OKLCH:
+		return fmt.Sprintf("oklch(%s %s %s)", cssNumber(c.L, 4), cssNumber(c.C, 4), cssNumber(c.H, 2))
+
+	case XYZ:
+		return fmt.Sprintf("color(xyz-d65 %s %s %s)", cssNumber(c.X, 5), cssNumber(c.Y, 5), cssNumber(c.Z, 5))
+
+	case SpaceRGB:
+		if name, ok := cssSpaceNames[c.Space]; ok {
+			return fmt.Sprintf("color(%s %s %s %s)", name, cssNumber(c.R, 5), cssNumber(c.G, 5), cssNumber(c.B, 5))
+		}
+	}
+
+	rgb := c.ToRGB64()
+	return fmt.Sprintf("rgb(%s %s %s)", cssNumber(rgb.R*255, 2), cssNumber(rgb.G*255, 2), cssNumber(rgb.B*255, 2))
+}
+
+// formatCSSLab formats a LAB color as lab(), which is relative to D50.
+func formatCSSLab(c LAB64) string {
+	lab := c.Adapt(D50, AdaptationBradford)
+	return fmt.Sprintf("lab(%s %s %s)", cssNumber(lab.L, 2), cssNumber(lab.A, 2), cssNumber(lab.B, 2))
+}
+
+// cssNumber formats v with at most the given decimal places, without trailing zeros.
+func cssNumber(v float64, places int) string {
+	s := strconv.FormatFloat(v, 'f', places, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
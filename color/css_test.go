@@ -0,0 +1,194 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  RGB
+		alpha float64
+	}{
+		"Hex":                 {"#FF8000", NewRGB(255, 128, 0), 1},
+		"Short hex":           {"#f80", NewRGB(255, 136, 0), 1},
+		"Short hex alpha":     {"#f808", NewRGB(255, 136, 0), 136.0 / 255},
+		"Hex alpha":           {"#ff000080", NewRGB(255, 0, 0), 128.0 / 255},
+		"Named":               {"RebeccaPurple", NewRGB(102, 51, 153), 1},
+		"Transparent":         {"transparent", NewRGB(0, 0, 0), 0},
+		"RGB":                 {"rgb(255 128 0)", NewRGB(255, 128, 0), 1},
+		"RGB percent":         {"rgb(100% 50% 0%)", NewRGB(255, 128, 0), 1},
+		"RGB alpha":           {"rgb(255 0 0 / 50%)", NewRGB(255, 0, 0), 0.5},
+		"Legacy RGBA":         {"rgba(255, 0, 0, 0.25)", NewRGB(255, 0, 0), 0.25},
+		"RGB none":            {"rgb(none 255 none)", NewRGB(0, 255, 0), 1},
+		"HSL":                 {"hsl(120 100% 25%)", NewRGB(0, 128, 0), 1},
+		"Legacy HSL":          {"hsl(120, 100%, 25%)", NewRGB(0, 128, 0), 1},
+		"HSL turn":            {"hsl(0.5turn 100% 50%)", NewRGB(0, 255, 255), 1},
+		"HSL rad":             {"hsl(3.14159rad 100% 50%)", NewRGB(0, 255, 255), 1},
+		"HWB":                 {"hwb(0 0% 0%)", NewRGB(255, 0, 0), 1},
+		"HWB tint":            {"hwb(240 20% 40%)", NewRGB(51, 51, 153), 1},
+		"HWB gray":            {"hwb(90 60% 60%)", NewRGB(128, 128, 128), 1},
+		"Lab":                 {"lab(54.29 80.8 69.89)", NewRGB(255, 0, 0), 1},
+		"Lab percent":         {"lab(100% 0 0)", NewRGB(255, 255, 255), 1},
+		"LCH":                 {"lch(54.29 106.84 40.86deg)", NewRGB(255, 0, 0), 1},
+		"OKLab":               {"oklab(0.628 0.2249 0.1258)", NewRGB(255, 0, 0), 1},
+		"OKLab percent":       {"oklab(62.8% 56.225% 31.45%)", NewRGB(255, 0, 0), 1},
+		"OKLCH":               {"oklch(0.628 0.2577 29.23)", NewRGB(255, 0, 0), 1},
+		"OKLCH alpha":         {"oklch(62.8% 0.2577 29.23 / 0.5)", NewRGB(255, 0, 0), 0.5},
+		"Color sRGB":          {"color(srgb 1 0.5 0)", NewRGB(255, 128, 0), 1},
+		"Color sRGB linear":   {"color(srgb-linear 1 0.2158 0)", NewRGB(255, 128, 0), 1},
+		"Color Display P3":    {"color(display-p3 0.9175 0.2003 0.1386)", NewRGB(255, 0, 0), 1},
+		"Color A98":           {"color(a98-rgb 0.8586 0 0)", NewRGB(255, 0, 0), 1},
+		"Color XYZ":           {"color(xyz-d65 0.95047 1 1.08883)", NewRGB(255, 255, 255), 1},
+		"Color XYZ D50":       {"color(xyz-d50 0.96422 1 0.82521)", NewRGB(255, 255, 255), 1},
+		"Whitespace and case": {"  RGB( 255  0  0 )  ", NewRGB(255, 0, 0), 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if rgb := got.ToRGB(); rgb != tt.want {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, rgb, tt.want)
+			}
+			if a := AlphaOf(got); math.Abs(a-tt.alpha) > 1e-9 {
+				t.Errorf("Parse(%q) alpha = %v, want %v", tt.input, a, tt.alpha)
+			}
+		})
+	}
+}
+
+func TestParseTypes(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"Hex":     {"#ff0000", "RGB"},
+		"RGB":     {"rgb(255 0 0)", "RGB"},
+		"HSL":     {"hsl(0 100% 50%)", "HSL"},
+		"Lab":     {"lab(50 20 -30)", "LAB"},
+		"LCH":     {"lch(50 20 30)", "LCH"},
+		"OKLab":   {"oklab(0.5 0.1 0)", "OKLAB"},
+		"OKLCH":   {"oklch(0.5 0.1 30)", "OKLCH"},
+		"P3":      {"color(display-p3 1 0 0)", "DisplayP3"},
+		"Rec2020": {"color(rec2020 1 0 0)", "Rec2020"},
+		"XYZ":     {"color(xyz 0.5 0.5 0.5)", "XYZ"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if cs := got.ColorSpace(); cs != tt.want {
+				t.Errorf("Parse(%q) color space = %s, want %s", tt.input, cs, tt.want)
+			}
+		})
+	}
+
+	// CSS lab() and lch() are relative to D50
+	lab, _ := Parse("lab(50 20 -30)")
+	if white := lab.(LAB64).WhitePoint(); white != D50 {
+		t.Errorf("Parse(lab()) white = %v, want D50", white)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"Empty":            "",
+		"Bad hex digits":   "#GGGGGG",
+		"Bad hex length":   "#FF000",
+		"Unknown name":     "notacolor",
+		"Unknown function": "cmyk(0 0 0 0)",
+		"Missing paren":    "rgb(255 0 0",
+		"Too few":          "rgb(255 0)",
+		"Too many":         "rgb(255 0 0 0)",
+		"Bad number":       "rgb(red 0 0)",
+		"Bad hue":          "hsl(10px 50% 50%)",
+		"Bad alpha":        "rgb(255 0 0 / half)",
+		"Unknown space":    "color(cmyk 1 0 0)",
+		"Missing space":    "color()",
+		"Legacy count":     "rgb(1, 2)",
+		"Empty alpha":      "rgb(255 0 0 /)",
+		"Current color":    "currentcolor",
+		"Trailing garbage": "rgb(255 0 0) x",
+		"NaN":              "rgb(nan 0 0)",
+		"Infinity":         "oklch(0.5 inf 120)",
+		"Infinite percent": "rgb(infinity% 0 0)",
+		"Infinite hue":     "hsl(-infdeg 50% 50%)",
+		"Infinite alpha":   "rgb(255 0 0 / inf)",
+		"Overflow":         "lab(1e400 0 0)",
+		"Commas in color":  "color(srgb, 1, 0, 0)",
+		"Commas in oklch":  "oklch(0.5, 0.1, 120)",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if c, err := Parse(input); err == nil {
+				t.Errorf("Parse(%q) = %v, want error", input, c)
+			}
+		})
+	}
+}
+
+func TestFormatCSS(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  string
+	}{
+		"RGB":         {NewRGB(255, 128, 0), "rgb(255 128 0)"},
+		"RGB64":       {NewRGB64(1, 0.5, 0), "rgb(255 127.5 0)"},
+		"CMYK":        {NewCMYK(0, 100, 100, 0), "rgb(255 0 0)"},
+		"HSL":         {NewHSL(210, 0.5, 0.4), "hsl(210 50% 40%)"},
+		"D50 Lab":     {NewLAB64WithWhite(50, 20, -30, D50), "lab(50 20 -30)"},
+		"D50 LCH":     {NewLCHWithWhite(50, 20, 30, D50), "lch(50 20 30)"},
+		"OKLab":       {NewOKLab(0.5, 0.1, -0.05), "oklab(0.5 0.1 -0.05)"},
+		"OKLCH":       {NewOKLCH(0.628, 0.2577, 29.23), "oklch(0.628 0.2577 29.23)"},
+		"XYZ":         {NewXYZ(0.5, 0.25, 0.125), "color(xyz-d65 0.5 0.25 0.125)"},
+		"P3":          {DisplayP3.New(1, 0, 0.5), "color(display-p3 1 0 0.5)"},
+		"Alpha":       {WithAlpha(NewRGB(255, 0, 0), 0.5), "rgb(255 0 0 / 0.5)"},
+		"OKLCH alpha": {WithAlpha(NewOKLCH(0.5, 0.1, 30), 0.25), "oklch(0.5 0.1 30 / 0.25)"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := FormatCSS(tt.color); got != tt.want {
+				t.Errorf("FormatCSS() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCSSRoundTrip(t *testing.T) {
+	colors := map[string]Color{
+		"RGB":   NewRGB(12, 34, 56),
+		"HSL":   NewHSL(200, 0.3, 0.6),
+		"LAB":   NewLAB64(60, -20, 35),
+		"LCH":   NewLCH(45, 30, 300),
+		"OKLab": NewOKLab(0.7, -0.05, 0.1),
+		"OKLCH": NewOKLCH(0.7, 0.15, 145),
+		"Gray":  NewGray(0.5),
+		"P3":    DisplayP3.New(0.2, 0.9, 0.4),
+		"Alpha": WithAlpha(NewOKLCH(0.6, 0.1, 20), 0.3),
+	}
+
+	for name, c := range colors {
+		t.Run(name, func(t *testing.T) {
+			s := FormatCSS(c)
+			got, err := Parse(s)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", s, err)
+			}
+			if d := DeltaE(c, got, DeltaECIEDE2000); d > 0.05 {
+				t.Errorf("Parse(FormatCSS()) = %v, want %v (ΔE %.3f)", got, c, d)
+			}
+			if AlphaOf(got) != AlphaOf(c) {
+				t.Errorf("Parse(FormatCSS()) alpha = %v, want %v", AlphaOf(got), AlphaOf(c))
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package color
+
+// cssNamedColors are the CSS Color Level 4 named colors, keyed by lowercase name.
+// See https://www.w3.org/TR/css-color-4/#named-colors
+var cssNamedColors = map[string]RGB{
+	"aliceblue":            NewRGB(240, 248, 255),
+	"antiquewhite":         NewRGB(250, 235, 215),
+	"aqua":                 NewRGB(0, 255, 255),
+	"aquamarine":           NewRGB(127, 255, 212),
+	"azure":                NewRGB(240, 255, 255),
+	"beige":                NewRGB(245, 245, 220),
+	"bisque":               NewRGB(255, 228, 196),
+	"black":                NewRGB(0, 0, 0),
+	"blanchedalmond":       NewRGB(255, 235, 205),
+	"blue":                 NewRGB(0, 0, 255),
+	"blueviolet":           NewRGB(138, 43, 226),
+	"brown":                NewRGB(165, 42, 42),
+	"burlywood":            NewRGB(222, 184, 135),
+	"cadetblue":            NewRGB(95, 158, 160),
+	"chartreuse":           NewRGB(127, 255, 0),
+	"chocolate":            NewRGB(210, 105, 30),
+	"coral":                NewRGB(255, 127, 80),
+	"cornflowerblue":       NewRGB(100, 149, 237),
+	"cornsilk":             NewRGB(255, 248, 220),
+	"crimson":              NewRGB(220, 20, 60),
+	"cyan":                 NewRGB(0, 255, 255),
+	"darkblue":             NewRGB(0, 0, 139),
+	"darkcyan":             NewRGB(0, 139, 139),
+	"darkgoldenrod":        NewRGB(184, 134, 11),
+	"darkgray":             NewRGB(169, 169, 169),
+	"darkgreen":            NewRGB(0, 100, 0),
+	"darkgrey":             NewRGB(169, 169, 169),
+	"darkkhaki":            NewRGB(189, 183, 107),
+	"darkmagenta":          NewRGB(139, 0, 139),
+	"darkolivegreen":       NewRGB(85, 107, 47),
+	"darkorange":           NewRGB(255, 140, 0),
+	"darkorchid":           NewRGB(153, 50, 204),
+	"darkred":              NewRGB(139, 0, 0),
+	"darksalmon":           NewRGB(233, 150, 122),
+	"darkseagreen":         NewRGB(143, 188, 143),
+	"darkslateblue":        NewRGB(72, 61, 139),
+	"darkslategray":        NewRGB(47, 79, 79),
+	"darkslategrey":        NewRGB(47, 79, 79),
+	"darkturquoise":        NewRGB(0, 206, 209),
+	"darkviolet":           NewRGB(148, 0, 211),
+	"deeppink":             NewRGB(255, 20, 147),
+	"deepskyblue":          NewRGB(0, 191, 255),
+	"dimgray":              NewRGB(105, 105, 105),
+	"dimgrey":              NewRGB(105, 105, 105),
+	"dodgerblue":           NewRGB(30, 144, 255),
+	"firebrick":            NewRGB(178, 34, 34),
+	"floralwhite":          NewRGB(255, 250, 240),
+	"forestgreen":          NewRGB(34, 139, 34),
+	"fuchsia":              NewRGB(255, 0, 255),
+	"gainsboro":            NewRGB(220, 220, 220),
+	"ghostwhite":           NewRGB(248, 248, 255),
+	"gold":                 NewRGB(255, 215, 0),
+	"goldenrod":            NewRGB(218, 165, 32),
+	"gray":                 NewRGB(128, 128, 128),
+	"green":                NewRGB(0, 128, 0),
+	"greenyellow":          NewRGB(173, 255, 47),
+	"grey":                 NewRGB(128, 128, 128),
+	"honeydew":             NewRGB(240, 255, 240),
+	"hotpink":              NewRGB(255, 105, 180),
+	"indianred":            NewRGB(205, 92, 92),
+	"indigo":               NewRGB(75, 0, 130),
+	"ivory":                NewRGB(255, 255, 240),
+	"khaki":                NewRGB(240, 230, 140),
+	"lavender":             NewRGB(230, 230, 250),
+	"lavenderblush":        NewRGB(255, 240, 245),
+	"lawngreen":            NewRGB(124, 252, 0),
+	"lemonchiffon":         NewRGB(255, 250, 205),
+	"lightblue":            NewRGB(173, 216, 230),
+	"lightcoral":           NewRGB(240, 128, 128),
+	"lightcyan":            NewRGB(224, 255, 255),
+	"lightgoldenrodyellow": NewRGB(250, 250, 210),
+	"lightgray":            NewRGB(211, 211, 211),
+	"lightgreen":           NewRGB(144, 238, 144),
+	"lightgrey":            NewRGB(211, 211, 211),
+	"lightpink":            NewRGB(255, 182, 193),
+	"lightsalmon":          NewRGB(255, 160, 122),
+	"lightseagreen":        NewRGB(32, 178, 170),
+	"lightskyblue":         NewRGB(135, 206, 250),
+	"lightslategray":       NewRGB(119, 136, 153),
+	"lightslategrey":       NewRGB(119, 136, 153),
+	"lightsteelblue":       NewRGB(176, 196, 222),
+	"lightyellow":          NewRGB(255, 255, 224),
+	"lime":                 NewRGB(0, 255, 0),
+	"limegreen":            NewRGB(50, 205, 50),
+	"linen":                NewRGB(250, 240, 230),
+	"magenta":              NewRGB(255, 0, 255),
+	"maroon":               NewRGB(128, 0, 0),
+	"mediumaquamarine":     NewRGB(102, 205, 170),
+	"mediumblue":           NewRGB(0, 0, 205),
+	"mediumorchid":         NewRGB(186, 85, 211),
+	"mediumpurple":         NewRGB(147, 112, 219),
+	"mediumseagreen":       NewRGB(60, 179, 113),
+	"mediumslateblue":      NewRGB(123, 104, 238),
+	"mediumspringgreen":    NewRGB(0, 250, 154),
+	"mediumturquoise":      NewRGB(72, 209, 204),
+	"mediumvioletred":      NewRGB(199, 21, 133),
+	"midnightblue":         NewRGB(25, 25, 112),
+	"mintcream":            NewRGB(245, 255, 250),
+	"mistyrose":            NewRGB(255, 228, 225),
+	"moccasin":             NewRGB(255, 228, 181),
+	"navajowhite":          NewRGB(255, 222, 173),
+	"navy":                 NewRGB(0, 0, 128),
+	"oldlace":              NewRGB(253, 245, 230),
+	"olive":                NewRGB(128, 128, 0),
+	"olivedrab":            NewRGB(107, 142, 35),
+	"orange":               NewRGB(255, 165, 0),
+	"orangered":            NewRGB(255, 69, 0),
+	"orchid":               NewRGB(218, 112, 214),
+	"palegoldenrod":        NewRGB(238, 232, 170),
+	"palegreen":            NewRGB(152, 251, 152),
+	"paleturquoise":        NewRGB(175, 238, 238),
+	"palevioletred":        NewRGB(219, 112, 147),
+	"papayawhip":           NewRGB(255, 239, 213),
+	"peachpuff":            NewRGB(255, 218, 185),
+	"peru":                 NewRGB(205, 133, 63),
+	"pink":                 NewRGB(255, 192, 203),
+	"plum":                 NewRGB(221, 160, 221),
+	"powderblue":           NewRGB(176, 224, 230),
+	"purple":               NewRGB(128, 0, 128),
+	"rebeccapurple":        NewRGB(102, 51, 153),
+	"red":                  NewRGB(255, 0, 0),
+	"rosybrown":            NewRGB(188, 143, 143),
+	"royalblue":            NewRGB(65, 105, 225),
+	"saddlebrown":          NewRGB(139, 69, 19),
+	"salmon":               NewRGB(250, 128, 114),
+	"sandybrown":           NewRGB(244, 164, 96),
+	"seagreen":             NewRGB(46, 139, 87),
+	"seashell":             NewRGB(255, 245, 238),
+	"sienna":               NewRGB(160, 82, 45),
+	"silver":               NewRGB(192, 192, 192),
+	"skyblue":              NewRGB(135, 206, 235),
+	"slateblue":            NewRGB(106, 90, 205),
+	"slategray":            NewRGB(112, 128, 144),
+	"slategrey":            NewRGB(112, 128, 144),
+	"snow":                 NewRGB(255, 250, 250),
+	"springgreen":          NewRGB(0, 255, 127),
+	"steelblue":            NewRGB(70, 130, 180),
+	"tan":                  NewRGB(210, 180, 140),
+	"teal":                 NewRGB(0, 128, 128),
+	"thistle":              NewRGB(216, 191, 216),
+	"tomato":               NewRGB(255, 99, 71),
+	"turquoise":            NewRGB(64, 224, 208),
+	"violet":               NewRGB(238, 130, 238),
+	"wheat":                NewRGB(245, 222, 179),
+	"white":                NewRGB(255, 255, 255),
+	"whitesmoke":           NewRGB(245, 245, 245),
+	"yellow":               NewRGB(255, 255, 0),
+	"yellowgreen":          NewRGB(154, 205, 50),
+}
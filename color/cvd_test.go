@@ -0,0 +1,121 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMachadoMatricesKeepWhite(t *testing.T) {
+	tables := map[string]*[11]mat3{
+		"Protan": &machadoProtan,
+		"Deutan": &machadoDeutan,
+		"Tritan": &machadoTritan,
+	}
+
+	for name, table := range tables {
+		t.Run(name, func(t *testing.T) {
+			for i, m := range table {
+				for row := range 3 {
+					if sum := m[row][0] + m[row][1] + m[row][2]; math.Abs(sum-1) > 1e-5 {
+						t.Errorf("severity %.1f row %d sums to %.6f, want 1", float64(i)/10, row, sum)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSimulateCVD(t *testing.T) {
+	red, green := NewRGB(255, 0, 0), NewRGB(0, 128, 0)
+	blue, yellow := NewRGB(0, 0, 255), NewRGB(255, 255, 0)
+
+	tests := map[string]struct {
+		a, b       Color
+		deficiency Deficiency
+		maxDeltaE  float64 // CIEDE2000 between the simulated colors
+	}{
+		"Protanopia confuses red and green":   {red, green, DeficiencyProtan, 25},
+		"Deuteranopia confuses red and green": {red, green, DeficiencyDeutan, 20},
+		"Tritanopia confuses blue and green":  {NewRGB(0, 130, 200), NewRGB(0, 150, 110), DeficiencyTritan, 12},
+		"Achromatopsia loses all hue":         {blue, NewRGB(76, 76, 76), DeficiencyAchromatic, 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			before := DeltaE(tt.a, tt.b, DeltaECIEDE2000)
+			after := DeltaE(SimulateCVD(tt.a, tt.deficiency, 1), SimulateCVD(tt.b, tt.deficiency, 1), DeltaECIEDE2000)
+			if after > tt.maxDeltaE || after >= before {
+				t.Errorf("ΔE2000 after simulation = %.2f (before %.2f), want at most %.2f", after, before, tt.maxDeltaE)
+			}
+		})
+	}
+
+	// Blue and yellow stay distinct for red-green deficiencies
+	if d := DeltaE(SimulateCVD(blue, DeficiencyDeutan, 1), SimulateCVD(yellow, DeficiencyDeutan, 1), DeltaECIEDE2000); d < 50 {
+		t.Errorf("deutan blue/yellow ΔE2000 = %.2f, want at least 50", d)
+	}
+}
+
+func TestSimulateCVDSeverity(t *testing.T) {
+	c := NewRGB(200, 80, 40)
+
+	for _, deficiency := range []Deficiency{DeficiencyProtan, DeficiencyDeutan, DeficiencyTritan, DeficiencyAchromatic} {
+		t.Run(deficiency.String(), func(t *testing.T) {
+			if got := SimulateCVD(c, deficiency, 0).ToRGB(); got != c {
+				t.Errorf("SimulateCVD(severity 0) = %v, want %v", got, c)
+			}
+			if got := SimulateCVD(NewRGB(255, 255, 255), deficiency, 0.65).ToRGB(); got != NewRGB(255, 255, 255) {
+				t.Errorf("SimulateCVD(white) = %v, want white", got)
+			}
+
+			mild := DeltaE(c, SimulateCVD(c, deficiency, 0.3), DeltaECIEDE2000)
+			full := DeltaE(c, SimulateCVD(c, deficiency, 1), DeltaECIEDE2000)
+			if mild <= 0 || mild >= full {
+				t.Errorf("ΔE2000 at severity 0.3 = %.2f, at 1.0 = %.2f; want 0 < mild < full", mild, full)
+			}
+		})
+	}
+
+	// Severities between table rows interpolate the matrices
+	m := machadoMatrix(DeficiencyDeutan, 0.25)
+	if want := (machadoDeutan[2][0][0] + machadoDeutan[3][0][0]) / 2; math.Abs(m[0][0]-want) > 1e-9 {
+		t.Errorf("machadoMatrix(0.25)[0][0] = %v, want %v", m[0][0], want)
+	}
+}
+
+func TestSimulateCVDAchromatic(t *testing.T) {
+	got := SimulateCVD(WithAlpha(NewRGB(0, 128, 255), 0.5), DeficiencyAchromatic, 1)
+
+	if AlphaOf(got) != 0.5 {
+		t.Errorf("SimulateCVD() alpha = %v, want 0.5", AlphaOf(got))
+	}
+	if rgb := got.ToRGB64(); math.Abs(rgb.R-rgb.G) > 1e-9 || math.Abs(rgb.G-rgb.B) > 1e-9 {
+		t.Errorf("SimulateCVD() = %v, want a gray", rgb)
+	}
+}
+
+func TestParseDeficiency(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    Deficiency
+		wantErr bool
+	}{
+		"Protanopia":    {"protanopia", DeficiencyProtan, false},
+		"Deuteranomaly": {"Deuteranomaly", DeficiencyDeutan, false},
+		"Tritan":        {"tritan", DeficiencyTritan, false},
+		"Achromatopsia": {"achromatopsia", DeficiencyAchromatic, false},
+		"Invalid":       {"colorful", 0, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseDeficiency(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDeficiency() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDeficiency() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package color
+
+import "math"
+
+//go:generate go tool stringer -type=DeltaEMethod -trimprefix=DeltaE
+type DeltaEMethod int // Color difference formula
+
+const (
+	DeltaECIE76             DeltaEMethod = iota // Euclidean distance in Lab
+	DeltaECIE94GraphicArts                      // CIE94 with graphic arts weights
+	DeltaECIE94Textiles                         // CIE94 with textile weights
+	DeltaECIEDE2000                             // CIEDE2000
+	DeltaECMCAcceptability                      // CMC l:c with 2:1, for acceptability
+	DeltaECMCPerceptibility                     // CMC l:c with 1:1, for perceptibility
+)
+
+// DeltaE returns the difference between two colors using the given formula, computed
+// from their D65 Lab values. CIE94 and CMC are not symmetric; reference is the standard
+// the sample is judged against.
+func DeltaE(reference, sample Color, method DeltaEMethod) float64 {
+	return deltaE(reference.ToLAB64(), sample.ToLAB64(), method)
+}
+
+// deltaE returns the difference between two Lab values using the given formula.
+func deltaE(r, s LAB64, method DeltaEMethod) float64 {
+	switch method {
+	case DeltaECIE94GraphicArts:
+		return deltaE94(r, s, 1, 0.045, 0.015)
+	case DeltaECIE94Textiles:
+		return deltaE94(r, s, 2, 0.048, 0.014)
+	case DeltaECIEDE2000:
+		return deltaE2000(r, s)
+	case DeltaECMCAcceptability:
+		return deltaECMC(r, s, 2, 1)
+	case DeltaECMCPerceptibility:
+		return deltaECMC(r, s, 1, 1)
+	default:
+		return deltaE76(r, s)
+	}
+}
+
+// DeltaECMC returns the CMC l:c difference between two colors with custom lightness
+// and chroma weights.
+func DeltaECMC(reference, sample Color, l, c float64) float64 {
+	return deltaECMC(reference.ToLAB64(), sample.ToLAB64(), l, c)
+}
+
+func deltaE76(r, s LAB64) float64 {
+	return math.Sqrt(sq(r.L-s.L) + sq(r.A-s.A) + sq(r.B-s.B))
+}
+
+func deltaE94(r, s LAB64, kL, k1, k2 float64) float64 {
+	c1 := math.Hypot(r.A, r.B)
+	c2 := math.Hypot(s.A, s.B)
+
+	dL := r.L - s.L
+	dC := c1 - c2
+	dH2 := math.Max(sq(r.A-s.A)+sq(r.B-s.B)-sq(dC), 0)
+
+	sC := 1 + k1*c1
+	sH := 1 + k2*c1
+
+	return math.Sqrt(sq(dL/kL) + sq(dC/sC) + dH2/sq(sH))
+}
+
+func deltaE2000(r, s LAB64) float64 {
+	// Adjust a* for the blue region
+	cBar := (math.Hypot(r.A, r.B) + math.Hypot(s.A, s.B)) / 2
+	g := 0.5 * (1 - math.Sqrt(math.Pow(cBar, 7)/(math.Pow(cBar, 7)+math.Pow(25, 7))))
+
+	a1, a2 := r.A*(1+g), s.A*(1+g)
+	c1, c2 := math.Hypot(a1, r.B), math.Hypot(a2, s.B)
+	h1, h2 := hueAngle(a1, r.B), hueAngle(a2, s.B)
+
+	dL := s.L - r.L
+	dC := c2 - c1
+
+	var dh float64
+	if c1*c2 != 0 {
+		dh = h2 - h1
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(c1*c2) * math.Sin(dh*math.Pi/360)
+
+	lBar := (r.L + s.L) / 2
+	cBarPrime := (c1 + c2) / 2
+
+	hBar := h1 + h2
+	if c1*c2 != 0 {
+		if math.Abs(h1-h2) > 180 {
+			if hBar < 360 {
+				hBar += 360
+			} else {
+				hBar -= 360
+			}
+		}
+		hBar /= 2
+	}
+
+	t := 1 - 0.17*cosDeg(hBar-30) + 0.24*cosDeg(2*hBar) + 0.32*cosDeg(3*hBar+6) - 0.20*cosDeg(4*hBar-63)
+
+	sL := 1 + 0.015*sq(lBar-50)/math.Sqrt(20+sq(lBar-50))
+	sC := 1 + 0.045*cBarPrime
+	sH := 1 + 0.015*cBarPrime*t
+
+	dTheta := 30 * math.Exp(-sq((hBar-275)/25))
+	rC := 2 * math.Sqrt(math.Pow(cBarPrime, 7)/(math.Pow(cBarPrime, 7)+math.Pow(25, 7)))
+	rT := -math.Sin(2*dTheta*math.Pi/180) * rC
+
+	return math.Sqrt(sq(dL/sL) + sq(dC/sC) + sq(dH/sH) + rT*(dC/sC)*(dH/sH))
+}
+
+func deltaECMC(r, s LAB64, l, c float64) float64 {
+	c1 := math.Hypot(r.A, r.B)
+	c2 := math.Hypot(s.A, s.B)
+	h1 := hueAngle(r.A, r.B)
+
+	dL := r.L - s.L
+	dC := c1 - c2
+	dH2 := math.Max(sq(r.A-s.A)+sq(r.B-s.B)-sq(dC), 0)
+
+	sL := 0.511
+	if r.L >= 16 {
+		sL = 0.040975 * r.L / (1 + 0.01765*r.L)
+	}
+	sC := 0.0638*c1/(1+0.0131*c1) + 0.638
+
+	var t float64
+	if h1 >= 164 && h1 <= 345 {
+		t = 0.56 + math.Abs(0.2*cosDeg(h1+168))
+	} else {
+		t = 0.36 + math.Abs(0.4*cosDeg(h1+35))
+	}
+	f := math.Sqrt(math.Pow(c1, 4) / (math.Pow(c1, 4) + 1900))
+	sH := sC * (f*t + 1 - f)
+
+	return math.Sqrt(sq(dL/(l*sL)) + sq(dC/(c*sC)) + dH2/sq(sH))
+}
+
+// hueAngle returns the hue angle of a and b in degrees, in the range 0-360.
+func hueAngle(a, b float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func cosDeg(deg float64) float64 {
+	return math.Cos(deg * math.Pi / 180)
+}
+
+func sq(v float64) float64 {
+	return v * v
+}
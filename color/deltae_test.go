@@ -0,0 +1,119 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+// TestDeltaE2000Sharma checks CIEDE2000 against the test data published with
+// G. Sharma, W. Wu and E. N. Dalal, "The CIEDE2000 Color-Difference Formula:
+// Implementation Notes, Supplementary Test Data, and Mathematical Observations" (2005).
+func TestDeltaE2000Sharma(t *testing.T) {
+	tests := []struct {
+		l1, a1, b1 float64
+		l2, a2, b2 float64
+		want       float64
+	}{
+		{50.0000, 2.6772, -79.7751, 50.0000, 0.0000, -82.7485, 2.0425},
+		{50.0000, 3.1571, -77.2803, 50.0000, 0.0000, -82.7485, 2.8615},
+		{50.0000, 2.8361, -74.0200, 50.0000, 0.0000, -82.7485, 3.4412},
+		{50.0000, -1.3802, -84.2814, 50.0000, 0.0000, -82.7485, 1.0000},
+		{50.0000, -1.1848, -84.8006, 50.0000, 0.0000, -82.7485, 1.0000},
+		{50.0000, -0.9009, -85.5211, 50.0000, 0.0000, -82.7485, 1.0000},
+		{50.0000, 0.0000, 0.0000, 50.0000, -1.0000, 2.0000, 2.3669},
+		{50.0000, -1.0000, 2.0000, 50.0000, 0.0000, 0.0000, 2.3669},
+		{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0009, 7.1792},
+		{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0010, 7.1792},
+		{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0011, 7.2195},
+		{50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0012, 7.2195},
+		{50.0000, -0.0010, 2.4900, 50.0000, 0.0009, -2.4900, 4.8045},
+		{50.0000, -0.0010, 2.4900, 50.0000, 0.0010, -2.4900, 4.8045},
+		{50.0000, -0.0010, 2.4900, 50.0000, 0.0011, -2.4900, 4.7461},
+		{50.0000, 2.5000, 0.0000, 50.0000, 0.0000, -2.5000, 4.3065},
+		{50.0000, 2.5000, 0.0000, 73.0000, 25.0000, -18.0000, 27.1492},
+		{50.0000, 2.5000, 0.0000, 61.0000, -5.0000, 29.0000, 22.8977},
+		{50.0000, 2.5000, 0.0000, 56.0000, -27.0000, -3.0000, 31.9030},
+		{50.0000, 2.5000, 0.0000, 58.0000, 24.0000, 15.0000, 19.4535},
+		{50.0000, 2.5000, 0.0000, 50.0000, 3.1736, 0.5854, 1.0000},
+		{50.0000, 2.5000, 0.0000, 50.0000, 3.2972, 0.0000, 1.0000},
+		{50.0000, 2.5000, 0.0000, 50.0000, 1.8634, 0.5757, 1.0000},
+		{50.0000, 2.5000, 0.0000, 50.0000, 3.2592, 0.3350, 1.0000},
+		{60.2574, -34.0099, 36.2677, 60.4626, -34.1751, 39.4387, 1.2644},
+		{63.0109, -31.0961, -5.8663, 62.8187, -29.7946, -4.0864, 1.2630},
+		{61.2901, 3.7196, -5.3901, 61.4292, 2.2480, -4.9620, 1.8731},
+		{35.0831, -44.1164, 3.7933, 35.0232, -40.0716, 1.5901, 1.8645},
+		{22.7233, 20.0904, -46.6940, 23.0331, 14.9730, -42.5619, 2.0373},
+		{36.4612, 47.8580, 18.3852, 36.2715, 50.5065, 21.2231, 1.4146},
+		{90.8027, -2.0831, 1.4410, 91.1528, -1.6435, 0.0447, 1.4441},
+		{90.9257, -0.5406, -0.9208, 88.6381, -0.8985, -0.7239, 1.5381},
+		{6.7747, -0.2908, -2.4247, 5.8714, -0.0985, -2.2286, 0.6377},
+		{2.0776, 0.0795, -1.1350, 0.9033, -0.0636, -0.5514, 0.9082},
+	}
+
+	for i, tt := range tests {
+		c1 := NewLAB64(tt.l1, tt.a1, tt.b1)
+		c2 := NewLAB64(tt.l2, tt.a2, tt.b2)
+
+		if got := DeltaE(c1, c2, DeltaECIEDE2000); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("pair %d: DeltaE() = %.4f, want %.4f", i+1, got, tt.want)
+		}
+		if got := DeltaE(c2, c1, DeltaECIEDE2000); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("pair %d reversed: DeltaE() = %.4f, want %.4f", i+1, got, tt.want)
+		}
+	}
+}
+
+func TestDeltaE(t *testing.T) {
+	// Reference values from python-colormath's accuracy tests
+	reference := NewLAB64(0.9, 16.3, -2.22)
+	sample := NewLAB64(0.7, 14.2, -1.80)
+
+	tests := map[string]struct {
+		method DeltaEMethod
+		want   float64
+	}{
+		"CIE76":              {DeltaECIE76, 2.151},
+		"CIE94 graphic arts": {DeltaECIE94GraphicArts, 1.249},
+		"CIE94 textiles":     {DeltaECIE94Textiles, 1.204},
+		"CIEDE2000":          {DeltaECIEDE2000, 1.523},
+		"CMC 2:1":            {DeltaECMCAcceptability, 1.443},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := DeltaE(reference, sample, tt.method); math.Abs(got-tt.want) > 1e-3 {
+				t.Errorf("DeltaE() = %.4f, want %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeltaEAnyColor(t *testing.T) {
+	red := NewRGB(255, 0, 0)
+
+	for method := DeltaECIE76; method <= DeltaECMCPerceptibility; method++ {
+		t.Run(method.String(), func(t *testing.T) {
+			if got := DeltaE(red, red.ToXYZ().ToOKLCH(), method); got > 1e-6 {
+				t.Errorf("DeltaE() of the same color = %v, want 0", got)
+			}
+			if got := DeltaE(red, NewCMYK(0, 100, 100, 0), method); got > 1e-6 {
+				t.Errorf("DeltaE() of equivalent colors = %v, want 0", got)
+			}
+			if got := DeltaE(red, NewRGB(0, 0, 255), method); got < 10 {
+				t.Errorf("DeltaE() of red and blue = %v, want a large difference", got)
+			}
+		})
+	}
+}
+
+func TestDeltaECMC(t *testing.T) {
+	reference := NewLAB64(50, 20, -10)
+	sample := NewLAB64(55, 18, -5)
+
+	if got, want := DeltaECMC(reference, sample, 2, 1), DeltaE(reference, sample, DeltaECMCAcceptability); got != want {
+		t.Errorf("DeltaECMC(2, 1) = %v, want %v", got, want)
+	}
+	if got, want := DeltaECMC(reference, sample, 1, 1), DeltaE(reference, sample, DeltaECMCPerceptibility); got != want {
+		t.Errorf("DeltaECMC(1, 1) = %v, want %v", got, want)
+	}
+}
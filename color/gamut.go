@@ -0,0 +1,155 @@
+package color
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+//go:generate go tool stringer -type=GamutMapping -trimprefix=GamutMapping
+type GamutMapping int // Strategy for bringing out-of-gamut colors into a space
+
+const (
+	GamutMappingClip        GamutMapping = iota // Clamp each RGB component
+	GamutMappingLChChroma                       // Reduce CIE LCh chroma at constant lightness and hue
+	GamutMappingOKLCHChroma                     // Reduce OKLCH chroma at constant lightness and hue
+	GamutMappingCSS                             // CSS Color 4 gamut mapping: OKLCH chroma reduction with a ΔEOK tolerance
+)
+
+// ParseGamutMapping parses a gamut mapping name: "clip", "lch", "oklch" or "css".
+func ParseGamutMapping(s string) (GamutMapping, error) {
+	switch strings.ToLower(s) {
+	case "clip":
+		return GamutMappingClip, nil
+	case "lch", "lchchroma":
+		return GamutMappingLChChroma, nil
+	case "oklch", "oklchchroma":
+		return GamutMappingOKLCHChroma, nil
+	case "css", "css4":
+		return GamutMappingCSS, nil
+	default:
+		return 0, fmt.Errorf("invalid gamut mapping: %s (must be one of: clip, lch, oklch, css)", s)
+	}
+}
+
+// gamutEpsilon absorbs floating point error when testing linear components against 0-1.
+const gamutEpsilon = 1e-5
+
+// Gamut search tolerances from CSS Color 4.
+const (
+	cssJND         = 0.02   // Just noticeable difference in ΔEOK
+	chromaEpsilon  = 0.0001 // Chroma resolution of the binary search
+	lchChromaScale = 100    // CIE LCh chroma is ~100x OKLCH chroma
+)
+
+// InGamut reports whether c can be represented in the space without clipping.
+func (s *RGBSpace) InGamut(c Color) bool {
+	return s.inGamut(Opaque(c).ToXYZ())
+}
+
+func (s *RGBSpace) inGamut(c XYZ) bool {
+	r, g, b := s.linear(c)
+	for _, v := range []float64{r, g, b} {
+		if v < -gamutEpsilon || v > 1+gamutEpsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// MapToGamut returns c in the space, using method to bring it into gamut if needed.
+// In-gamut colors are converted unchanged.
+func (s *RGBSpace) MapToGamut(c Color, method GamutMapping) SpaceRGB {
+	xyz := Opaque(c).ToXYZ()
+	if s.inGamut(xyz) {
+		return s.FromXYZ(xyz)
+	}
+
+	switch method {
+	case GamutMappingLChChroma:
+		lch := xyz.ToLCH()
+		if lch.L >= 100 || lch.L <= 0 {
+			return s.FromXYZ(xyz)
+		}
+		return s.FromXYZ(s.reduceChroma(lch.C, chromaEpsilon*lchChromaScale, func(chroma float64) XYZ {
+			return NewLCH(lch.L, chroma, lch.H).ToXYZ()
+		}))
+
+	case GamutMappingOKLCHChroma:
+		lch := xyz.ToOKLCH()
+		if lch.L >= 1 || lch.L <= 0 {
+			return s.FromXYZ(xyz)
+		}
+		return s.FromXYZ(s.reduceChroma(lch.C, chromaEpsilon, func(chroma float64) XYZ {
+			return NewOKLCH(lch.L, chroma, lch.H).ToXYZ()
+		}))
+
+	case GamutMappingCSS:
+		return s.cssGamutMap(xyz.ToOKLCH())
+
+	default:
+		return s.FromXYZ(xyz)
+	}
+}
+
+// reduceChroma binary searches for the largest chroma that is in gamut.
+func (s *RGBSpace) reduceChroma(chroma, epsilon float64, at func(float64) XYZ) XYZ {
+	lo, hi := 0.0, chroma
+	for hi-lo > epsilon {
+		mid := (lo + hi) / 2
+		if s.inGamut(at(mid)) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return at(lo)
+}
+
+// cssGamutMap implements the CSS Color 4 gamut mapping algorithm, which reduces chroma
+// until clipping the result is within a just noticeable difference.
+// See https://www.w3.org/TR/css-color-4/#binsearch
+func (s *RGBSpace) cssGamutMap(origin OKLCH) SpaceRGB {
+	if origin.L >= 1 {
+		return s.New(1, 1, 1)
+	}
+	if origin.L <= 0 {
+		return s.New(0, 0, 0)
+	}
+
+	current := origin
+	clipped := s.FromXYZ(current.ToXYZ())
+	if deltaEOK(clipped, current) < cssJND {
+		return clipped
+	}
+
+	lo, hi := 0.0, origin.C
+	loInGamut := true
+	for hi-lo > chromaEpsilon {
+		current.C = (lo + hi) / 2
+		xyz := current.ToXYZ()
+		if loInGamut && s.inGamut(xyz) {
+			lo = current.C
+			continue
+		}
+
+		clipped = s.FromXYZ(xyz)
+		e := deltaEOK(clipped, current)
+		if e < cssJND {
+			if cssJND-e < chromaEpsilon {
+				return clipped
+			}
+			loInGamut = false
+			lo = current.C
+		} else {
+			hi = current.C
+		}
+	}
+	return clipped
+}
+
+// deltaEOK is the Euclidean distance between two colors in OKLab.
+func deltaEOK(a, b Color) float64 {
+	x, y := a.ToXYZ().ToOKLab(), b.ToXYZ().ToOKLab()
+	return math.Sqrt(sq(x.L-y.L) + sq(x.A-y.A) + sq(x.B-y.B))
+}
@@ -0,0 +1,114 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInGamut(t *testing.T) {
+	p3Green := DisplayP3.New(0, 1, 0)
+	vividLab := NewLAB64(50, 100, -20)
+
+	tests := map[string]struct {
+		color Color
+		space *RGBSpace
+		want  bool
+	}{
+		"sRGB red in sRGB":       {NewRGB(255, 0, 0), SRGB, true},
+		"White in sRGB":          {NewRGB(255, 255, 255), SRGB, true},
+		"Black in ProPhoto":      {NewRGB(0, 0, 0), ProPhoto, true},
+		"P3 green in sRGB":       {p3Green, SRGB, false},
+		"P3 green in Display P3": {p3Green, DisplayP3, true},
+		"P3 green in Rec.2020":   {p3Green, Rec2020, true},
+		"Vivid Lab in sRGB":      {vividLab, SRGB, false},
+		"Vivid Lab in ProPhoto":  {vividLab, ProPhoto, true},
+		"With alpha":             {WithAlpha(p3Green, 0.5), SRGB, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.space.InGamut(tt.color); got != tt.want {
+				t.Errorf("InGamut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapToGamut(t *testing.T) {
+	p3Green := DisplayP3.New(0, 1, 0)
+	origin := p3Green.ToXYZ().ToOKLCH()
+
+	tests := map[string]struct {
+		method      GamutMapping
+		keepsOKLCHL bool // Lightness is held constant in OKLCH
+	}{
+		"Clip":         {GamutMappingClip, false},
+		"LCh chroma":   {GamutMappingLChChroma, false},
+		"OKLCH chroma": {GamutMappingOKLCHChroma, true},
+		"CSS":          {GamutMappingCSS, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := SRGB.MapToGamut(p3Green, tt.method)
+			if !SRGB.InGamut(got) {
+				t.Errorf("MapToGamut() = %v, want a color in gamut", got)
+			}
+
+			mapped := got.ToXYZ().ToOKLCH()
+			if tt.keepsOKLCHL && math.Abs(mapped.L-origin.L) > 0.02 {
+				t.Errorf("MapToGamut() lightness = %.4f, want %.4f", mapped.L, origin.L)
+			}
+			if tt.keepsOKLCHL && math.Abs(mapped.H-origin.H) > 3 { // CSS clips within a JND, which can shift hue slightly
+				t.Errorf("MapToGamut() hue = %.2f, want %.2f", mapped.H, origin.H)
+			}
+			if mapped.C >= origin.C {
+				t.Errorf("MapToGamut() chroma = %.4f, want less than %.4f", mapped.C, origin.C)
+			}
+		})
+	}
+}
+
+func TestMapToGamutKeepsInGamutColors(t *testing.T) {
+	c := NewRGB(200, 100, 50)
+
+	for method := GamutMappingClip; method <= GamutMappingCSS; method++ {
+		t.Run(method.String(), func(t *testing.T) {
+			if got := SRGB.MapToGamut(c, method).ToRGB(); got != c {
+				t.Errorf("MapToGamut() = %v, want %v", got, c)
+			}
+		})
+	}
+}
+
+func TestMapToGamutChromaReduction(t *testing.T) {
+	// Chroma reduction in CIE LCh keeps CIE lightness and hue
+	c := NewLCH(60, 120, 140)
+	got := SRGB.MapToGamut(c, GamutMappingLChChroma).ToXYZ().ToLCH()
+
+	if math.Abs(got.L-c.L) > 0.1 || math.Abs(got.H-c.H) > 0.5 || got.C >= c.C {
+		t.Errorf("MapToGamut() = %v, want L %.2f and H %.2f with less chroma", got, c.L, c.H)
+	}
+}
+
+func TestParseGamutMapping(t *testing.T) {
+	tests := map[string]GamutMapping{
+		"clip":  GamutMappingClip,
+		"LCH":   GamutMappingLChChroma,
+		"oklch": GamutMappingOKLCHChroma,
+		"css":   GamutMappingCSS,
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := ParseGamutMapping(input)
+			if err != nil || got != want {
+				t.Errorf("ParseGamutMapping(%q) = %v, %v, want %v", input, got, err, want)
+			}
+		})
+	}
+
+	if _, err := ParseGamutMapping("squash"); err == nil {
+		t.Error("ParseGamutMapping(\"squash\") error = nil")
+	}
+}
@@ -0,0 +1,67 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// Gray represents an achromatic color as a single gray level.
+// Y is in the range 0.0 (black) to 1.0 (white) and uses the sRGB transfer
+// curve, so Gray{Y} matches RGB64{Y, Y, Y}.
+type Gray struct {
+	Y float64
+}
+
+// NewGray creates a new gray color with validation.
+func NewGray(y float64) Gray {
+	return Gray{Y: clamp(y, 0, 1)}
+}
+
+func (c Gray) String() string {
+	return fmt.Sprintf("Gray(%.2f%%)", c.Y*100)
+}
+
+func (c Gray) ColorSpace() string {
+	return "GRAY"
+}
+
+func (c Gray) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c Gray) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c Gray) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c Gray) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c Gray) ToRGB64() RGB64 {
+	return NewRGB64(c.Y, c.Y, c.Y)
+}
+
+func (c Gray) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c Gray) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c Gray) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c Gray) ToXYZ() XYZ {
+	return c.ToRGB64().ToXYZ()
+}
+
+// ToGray converts XYZ values to a gray level with the same luminance.
+func (c XYZ) ToGray() Gray {
+	return NewGray(linearToSRGB(math.Max(c.Y, 0)))
+}
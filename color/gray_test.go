@@ -0,0 +1,62 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGray(t *testing.T) {
+	tests := map[string]struct {
+		gray Gray
+		want RGB
+	}{
+		"Black":      {NewGray(0), RGB{0, 0, 0}},
+		"White":      {NewGray(1), RGB{255, 255, 255}},
+		"Mid gray":   {NewGray(0.5), RGB{128, 128, 128}},
+		"Over range": {NewGray(1.5), RGB{255, 255, 255}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.gray.ToRGB(); got != tt.want {
+				t.Errorf("Gray.ToRGB() = %v, want %v", got, tt.want)
+			}
+			if got := tt.gray.ToXYZ().ToRGB(); got != tt.want {
+				t.Errorf("Gray.ToXYZ().ToRGB() = %v, want %v", got, tt.want)
+			}
+			if lab := tt.gray.ToLAB64(); math.Abs(lab.A) > 0.01 || math.Abs(lab.B) > 0.01 {
+				t.Errorf("Gray.ToLAB64() = %v, want neutral", lab)
+			}
+		})
+	}
+}
+
+func TestToGray(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  float64
+	}{
+		"Gray":  {NewGray(0.25), 0.25},
+		"RGB":   {NewRGB64(0.6, 0.6, 0.6), 0.6},
+		"Black": {NewCMYK64(0, 0, 0, 1), 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.color.ToXYZ().ToGray()
+			if math.Abs(got.Y-tt.want) > 1e-6 {
+				t.Errorf("ToGray() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrayString(t *testing.T) {
+	c := NewGray(0.125)
+	if got, want := c.String(), "Gray(12.50%)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got := c.ColorSpace(); got != "GRAY" {
+		t.Errorf("ColorSpace() = %v, want GRAY", got)
+	}
+}
@@ -0,0 +1,93 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// HSL represents a color in HSL color space, as used by CSS.
+// H is in degrees (0-360); S and L are in the range 0.0-1.0.
+type HSL struct {
+	H, S, L float64
+}
+
+// NewHSL creates a new HSL color with validation.
+func NewHSL(h, s, l float64) HSL {
+	return HSL{
+		H: normalizeHue(h),
+		S: clamp(s, 0, 1),
+		L: clamp(l, 0, 1),
+	}
+}
+
+func (c HSL) String() string {
+	return fmt.Sprintf("HSL(%.2f°, %.2f%%, %.2f%%)", c.H, c.S*100, c.L*100)
+}
+
+func (c HSL) ColorSpace() string {
+	return "HSL"
+}
+
+func (c HSL) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c HSL) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c HSL) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c HSL) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c HSL) ToRGB64() RGB64 {
+	s := clamp(c.S, 0, 1)
+	l := clamp(c.L, 0, 1)
+
+	// HSL and HSB share a hue; only the saturation and lightness axes differ.
+	v := l + s*math.Min(l, 1-l)
+	sv := 0.0
+	if v > 0 {
+		sv = 2 * (1 - l/v)
+	}
+
+	return HSB64{H: normalizeHue(c.H), S: sv, B: v}.ToRGB64()
+}
+
+func (c HSL) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c HSL) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c HSL) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c HSL) ToXYZ() XYZ {
+	return c.ToRGB64().ToXYZ()
+}
+
+// ToHSL converts the color to HSL.
+func (c RGB64) ToHSL() HSL {
+	hsb := c.ToHSB64()
+
+	l := hsb.B * (1 - hsb.S/2)
+	s := 0.0
+	if l > 0 && l < 1 {
+		s = (hsb.B - l) / math.Min(l, 1-l)
+	}
+
+	return HSL{H: hsb.H, S: s, L: l}
+}
+
+// ToHSL converts XYZ values to HSL.
+func (c XYZ) ToHSL() HSL {
+	return c.ToRGB64().ToHSL()
+}
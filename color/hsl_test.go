@@ -0,0 +1,61 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHSL(t *testing.T) {
+	tests := map[string]struct {
+		rgb  RGB
+		want HSL
+	}{
+		"Red":        {RGB{255, 0, 0}, HSL{0, 1, 0.5}},
+		"White":      {RGB{255, 255, 255}, HSL{0, 0, 1}},
+		"Black":      {RGB{0, 0, 0}, HSL{0, 0, 0}},
+		"Dark green": {RGB{0, 128, 0}, HSL{120, 1, 128.0 / 255 / 2}},
+		"Pale blue":  {RGB{191, 191, 255}, HSL{240, 1, 223.0 / 255}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.rgb.ToRGB64().ToHSL()
+			const tolerance = 1e-9
+			if math.Abs(got.H-tt.want.H) > tolerance ||
+				math.Abs(got.S-tt.want.S) > tolerance ||
+				math.Abs(got.L-tt.want.L) > tolerance {
+				t.Errorf("ToHSL() = %v, want %v", got, tt.want)
+			}
+
+			if back := got.ToRGB(); back != tt.rgb {
+				t.Errorf("HSL.ToRGB() = %v, want %v", back, tt.rgb)
+			}
+		})
+	}
+}
+
+func TestHSLString(t *testing.T) {
+	c := NewHSL(-90, 0.5, 1.5)
+	if got, want := c.String(), "HSL(270.00°, 50.00%, 100.00%)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got := c.ColorSpace(); got != "HSL" {
+		t.Errorf("ColorSpace() = %v, want HSL", got)
+	}
+}
+
+func TestHSLRoundTrip(t *testing.T) {
+	original := NewRGB64(0.2, 0.4, 0.6)
+
+	c := original
+	for range 10 {
+		c = c.ToHSL().ToRGB64()
+	}
+
+	const tolerance = 1e-9
+	if math.Abs(c.R-original.R) > tolerance ||
+		math.Abs(c.G-original.G) > tolerance ||
+		math.Abs(c.B-original.B) > tolerance {
+		t.Errorf("RGB64 -> HSL round trips drifted: %v -> %v", original, c)
+	}
+}
@@ -0,0 +1,42 @@
+package color
+
+import (
+	imagecolor "image/color"
+	"math"
+)
+
+// ToImageColor converts c for use with Go's image packages. The result is a
+// non-premultiplied 16-bit sRGB color that keeps c's alpha.
+func ToImageColor(c Color) imagecolor.Color {
+	rgb := c.ToRGB64()
+	return imagecolor.NRGBA64{
+		R: scaleUint16(rgb.R),
+		G: scaleUint16(rgb.G),
+		B: scaleUint16(rgb.B),
+		A: scaleUint16(AlphaOf(c)),
+	}
+}
+
+// FromImageColor converts a color from Go's image packages. Colors with 8-bit
+// components become RGB and others RGB64; translucent colors are wrapped with
+// their alpha.
+func FromImageColor(c imagecolor.Color) Color {
+	n := imagecolor.NRGBA64Model.Convert(c).(imagecolor.NRGBA64)
+
+	var rgb Color
+	if n.R%0x101 == 0 && n.G%0x101 == 0 && n.B%0x101 == 0 {
+		rgb = NewRGB(uint8(n.R>>8), uint8(n.G>>8), uint8(n.B>>8))
+	} else {
+		rgb = NewRGB64(float64(n.R)/0xffff, float64(n.G)/0xffff, float64(n.B)/0xffff)
+	}
+
+	if n.A < 0xffff {
+		return WithAlpha(rgb, float64(n.A)/0xffff)
+	}
+	return rgb
+}
+
+// scaleUint16 maps a 0-1 value onto 0-65535.
+func scaleUint16(v float64) uint16 {
+	return uint16(math.Round(clamp(v, 0, 1) * 0xffff))
+}
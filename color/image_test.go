@@ -0,0 +1,64 @@
+package color
+
+import (
+	imagecolor "image/color"
+	"math"
+	"testing"
+)
+
+func TestToImageColor(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  imagecolor.NRGBA64
+	}{
+		"RGB":   {NewRGB(255, 128, 0), imagecolor.NRGBA64{R: 0xffff, G: 0x8080, B: 0, A: 0xffff}},
+		"CMYK":  {NewCMYK(0, 100, 100, 0), imagecolor.NRGBA64{R: 0xffff, G: 0, B: 0, A: 0xffff}},
+		"Alpha": {WithAlpha(NewRGB(0, 0, 255), 0.5), imagecolor.NRGBA64{R: 0, G: 0, B: 0xffff, A: 0x8000}},
+		"Gray":  {NewGray(0), imagecolor.NRGBA64{A: 0xffff}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ToImageColor(tt.color); got != tt.want {
+				t.Errorf("ToImageColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromImageColor(t *testing.T) {
+	tests := map[string]struct {
+		color imagecolor.Color
+		want  Color
+	}{
+		"RGBA":          {imagecolor.RGBA{R: 255, G: 128, B: 0, A: 255}, NewRGB(255, 128, 0)},
+		"NRGBA alpha":   {imagecolor.NRGBA{R: 255, G: 0, B: 0, A: 51}, WithAlpha(NewRGB(255, 0, 0), 0.2)},
+		"Gray":          {imagecolor.Gray{Y: 64}, NewRGB(64, 64, 64)},
+		"16-bit":        {imagecolor.RGBA64{R: 0x8000, G: 0, B: 0xffff, A: 0xffff}, NewRGB64(float64(0x8000)/0xffff, 0, 1)},
+		"Transparent":   {imagecolor.Transparent, WithAlpha(NewRGB(0, 0, 0), 0)},
+		"Premultiplied": {imagecolor.RGBA{R: 128, G: 0, B: 0, A: 128}, WithAlpha(NewRGB(255, 0, 0), float64(0x8080)/0xffff)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := FromImageColor(tt.color); got != tt.want {
+				t.Errorf("FromImageColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageColorRoundTrip(t *testing.T) {
+	colors := []Color{
+		NewRGB(12, 34, 56),
+		WithAlpha(NewRGB(200, 100, 50), 0.4),
+		NewRGB64(0.1234, 0.5678, 0.9),
+	}
+
+	for _, c := range colors {
+		got := FromImageColor(ToImageColor(c))
+		if got.ToRGB() != c.ToRGB() || math.Abs(AlphaOf(got)-AlphaOf(c)) > 1e-4 {
+			t.Errorf("FromImageColor(ToImageColor(%v)) = %v", c, got)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateEndpoints(t *testing.T) {
+	a, b := NewRGB(255, 136, 0), NewRGB(30, 60, 200)
+
+	spaces := []InterpolationSpace{
+		InterpolateSRGB, InterpolateLinearRGB, InterpolateLab,
+		InterpolateLCh, InterpolateOKLab, InterpolateOKLCH,
+	}
+
+	for _, space := range spaces {
+		t.Run(space.String(), func(t *testing.T) {
+			if got := Interpolate(a, b, 0, space, HueShorter).ToRGB(); got != a {
+				t.Errorf("Interpolate(t=0) = %v, want %v", got, a)
+			}
+			if got := Interpolate(a, b, 1, space, HueShorter).ToRGB(); got != b {
+				t.Errorf("Interpolate(t=1) = %v, want %v", got, b)
+			}
+		})
+	}
+}
+
+func TestInterpolateMidpoint(t *testing.T) {
+	black, white := NewRGB(0, 0, 0), NewRGB(255, 255, 255)
+
+	tests := map[string]struct {
+		space InterpolationSpace
+		want  float64 // sRGB component of the mid gray
+	}{
+		"sRGB":       {InterpolateSRGB, 0.5},
+		"Linear RGB": {InterpolateLinearRGB, linearToSRGB(0.5)},
+		"Lab":        {InterpolateLab, NewLAB64(50, 0, 0).ToRGB64().R},
+		"OKLab":      {InterpolateOKLab, NewOKLab(0.5, 0, 0).ToRGB64().R},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Mix(black, white, tt.space).ToRGB64()
+			if math.Abs(got.R-tt.want) > 1e-4 || math.Abs(got.G-tt.want) > 1e-4 || math.Abs(got.B-tt.want) > 1e-4 {
+				t.Errorf("Mix() = %v, want gray %.4f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateHue(t *testing.T) {
+	tests := map[string]struct {
+		from, to float64
+		space    InterpolationSpace
+		hue      HueInterpolation
+		want     float64
+	}{
+		"OKLCH shorter across 0°": {350, 10, InterpolateOKLCH, HueShorter, 0},
+		"OKLCH longer across 0°":  {350, 10, InterpolateOKLCH, HueLonger, 180},
+		"OKLCH shorter":           {30, 150, InterpolateOKLCH, HueShorter, 90},
+		"OKLCH longer":            {30, 150, InterpolateOKLCH, HueLonger, 270},
+		"LCh shorter backwards":   {300, 60, InterpolateLCh, HueShorter, 0},
+		"LCh longer backwards":    {300, 60, InterpolateLCh, HueLonger, 180},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var a, b Color
+			if tt.space == InterpolateLCh {
+				a, b = NewLCH(60, 30, tt.from), NewLCH(60, 30, tt.to)
+			} else {
+				a, b = NewOKLCH(0.7, 0.08, tt.from), NewOKLCH(0.7, 0.08, tt.to)
+			}
+
+			var got float64
+			switch c := Interpolate(a, b, 0.5, tt.space, tt.hue).(type) {
+			case LCH:
+				got = c.H
+			case OKLCH:
+				got = c.H
+			default:
+				t.Fatalf("Interpolate() type = %T", c)
+			}
+
+			if d := math.Abs(normalizeHue(got-tt.want+180) - 180); d > 1e-6 {
+				t.Errorf("Interpolate() hue = %.4f°, want %.4f°", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateGrayHue(t *testing.T) {
+	red := NewOKLCH(0.6, 0.2, 30)
+
+	for _, hue := range []HueInterpolation{HueShorter, HueLonger} {
+		got := Interpolate(NewRGB(255, 255, 255), red, 0.5, InterpolateOKLCH, hue).(OKLCH)
+		if math.Abs(got.H-30) > 1e-6 {
+			t.Errorf("Interpolate(%v) from white hue = %.4f°, want 30°", hue, got.H)
+		}
+	}
+}
+
+func TestInterpolateAlpha(t *testing.T) {
+	a := WithAlpha(NewRGB(255, 0, 0), 0.2)
+	b := NewRGB(0, 0, 255)
+
+	if got := AlphaOf(Interpolate(a, b, 0.5, InterpolateSRGB, HueShorter)); math.Abs(got-0.6) > 1e-9 {
+		t.Errorf("Interpolate() alpha = %v, want 0.6", got)
+	}
+	if _, ok := Interpolate(NewRGB(255, 0, 0), b, 0.5, InterpolateSRGB, HueShorter).(AlphaColor); ok {
+		t.Error("Interpolate() of opaque colors has alpha")
+	}
+}
+
+func TestParseInterpolationSpace(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    InterpolationSpace
+		wantErr bool
+	}{
+		"sRGB":    {"srgb", InterpolateSRGB, false},
+		"Linear":  {"srgb-linear", InterpolateLinearRGB, false},
+		"Lab":     {"Lab", InterpolateLab, false},
+		"LCh":     {"LCH", InterpolateLCh, false},
+		"OKLab":   {"oklab", InterpolateOKLab, false},
+		"OKLCH":   {"oklch", InterpolateOKLCH, false},
+		"Invalid": {"hsv", 0, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseInterpolationSpace(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInterpolationSpace() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseInterpolationSpace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseHueInterpolation("sideways"); err == nil {
+		t.Error("ParseHueInterpolation() expected error")
+	}
+}
@@ -0,0 +1,115 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// LCH represents a color in CIE LCh(ab), the cylindrical form of LAB.
+// L is in the range 0-100; C is 0 or greater (typically up to 150); H is in degrees (0-360).
+//
+// White is the reference white the values are relative to. The zero value means D65.
+type LCH struct {
+	L, C, H float64
+	White   XYZ
+}
+
+// NewLCH creates a new D65 LCH color with validation.
+func NewLCH(l, c, h float64) LCH {
+	return NewLCHWithWhite(l, c, h, D65)
+}
+
+// NewLCHWithWhite creates a new LCH color relative to the given reference white.
+func NewLCHWithWhite(l, c, h float64, white XYZ) LCH {
+	lch := LCH{
+		L: clamp(l, 0, 100),
+		C: math.Max(c, 0),
+		H: normalizeHue(h),
+	}
+	if white != D65 {
+		lch.White = white
+	}
+	return lch
+}
+
+// WhitePoint returns the reference white of the color.
+func (c LCH) WhitePoint() XYZ {
+	if c.White == (XYZ{}) {
+		return D65
+	}
+	return c.White
+}
+
+func (c LCH) String() string {
+	if white := c.WhitePoint(); white != D65 {
+		name := whitePointName(white)
+		if name == "" {
+			name = white.String()
+		}
+		return fmt.Sprintf("LCH(%.2f, %.2f, %.2f°, %s)", c.L, c.C, c.H, name)
+	}
+	return fmt.Sprintf("LCH(%.2f, %.2f, %.2f°)", c.L, c.C, c.H)
+}
+
+func (c LCH) ColorSpace() string {
+	return "LCH"
+}
+
+func (c LCH) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c LCH) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c LCH) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c LCH) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c LCH) ToRGB64() RGB64 {
+	return c.ToXYZ().ToRGB64()
+}
+
+func (c LCH) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+// ToLAB64 returns the color as D65 LAB, adapting it with Bradford if it has
+// a different reference white.
+func (c LCH) ToLAB64() LAB64 {
+	return c.lab().ToLAB64()
+}
+
+func (c LCH) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c LCH) ToXYZ() XYZ {
+	return c.lab().ToXYZ()
+}
+
+// lab converts the color to LAB relative to its own reference white.
+func (c LCH) lab() LAB64 {
+	h := c.H * math.Pi / 180
+	return LAB64{L: c.L, A: c.C * math.Cos(h), B: c.C * math.Sin(h), White: c.White}
+}
+
+// ToLCH converts the color to LCH, keeping its reference white.
+func (c LAB64) ToLCH() LCH {
+	h := 0.0
+	chroma := math.Hypot(c.A, c.B)
+	if chroma > achromaticThreshold {
+		h = normalizeHue(math.Atan2(c.B, c.A) * 180 / math.Pi)
+	}
+	return LCH{L: c.L, C: chroma, H: h, White: c.White}
+}
+
+// ToLCH converts XYZ values to D65 LCH.
+func (c XYZ) ToLCH() LCH {
+	return c.ToLAB64().ToLCH()
+}
@@ -0,0 +1,63 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLCH(t *testing.T) {
+	tests := map[string]struct {
+		lab  LAB64
+		want LCH
+	}{
+		"Positive a":  {NewLAB64(50, 20, 0), LCH{L: 50, C: 20, H: 0}},
+		"Positive b":  {NewLAB64(50, 0, 20), LCH{L: 50, C: 20, H: 90}},
+		"Negative a":  {NewLAB64(50, -30, 40), LCH{L: 50, C: 50, H: 126.86989764584402}},
+		"Negative ab": {NewLAB64(50, -10, -10), LCH{L: 50, C: math.Sqrt2 * 10, H: 225}},
+		"Neutral":     {NewLAB64(50, 0, 0), LCH{L: 50, C: 0, H: 0}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.lab.ToLCH()
+			const tolerance = 1e-9
+			if math.Abs(got.L-tt.want.L) > tolerance ||
+				math.Abs(got.C-tt.want.C) > tolerance ||
+				math.Abs(got.H-tt.want.H) > tolerance {
+				t.Errorf("ToLCH() = %v, want %v", got, tt.want)
+			}
+
+			back := got.ToLAB64()
+			if math.Abs(back.L-tt.lab.L) > tolerance ||
+				math.Abs(back.A-tt.lab.A) > tolerance ||
+				math.Abs(back.B-tt.lab.B) > tolerance {
+				t.Errorf("LCH.ToLAB64() = %v, want %v", back, tt.lab)
+			}
+		})
+	}
+}
+
+func TestLCHWhitePoint(t *testing.T) {
+	d50 := NewLAB64WithWhite(54.29, 80.81, 69.89, D50)
+	lch := d50.ToLCH()
+
+	if got := lch.WhitePoint(); got != D50 {
+		t.Errorf("WhitePoint() = %v, want D50", got)
+	}
+	if got, want := lch.String(), "LCH(54.29, 106.84, 40.86°, D50)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got := lch.ToRGB(); got != (RGB{255, 0, 0}) {
+		t.Errorf("ToRGB() = %v, want RGB(255, 0, 0)", got)
+	}
+}
+
+func TestLCHString(t *testing.T) {
+	c := NewLCH(50, 30, 370)
+	if got, want := c.String(), "LCH(50.00, 30.00, 10.00°)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got := c.ColorSpace(); got != "LCH" {
+		t.Errorf("ColorSpace() = %v, want LCH", got)
+	}
+}
@@ -0,0 +1,622 @@
+package color
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MarshalJSON encodes any color of this package as a JSON object tagged with its
+// type, which UnmarshalJSON decodes back to exactly the same value:
+//
+//	{"type":"RGB","r":255,"g":0,"b":0}
+//	{"type":"LAB64","l":50,"a":20,"b":-30,"white":[0.96422,1,0.82521]}
+//	{"type":"DisplayP3","r":1,"g":0,"b":0}
+//	{"type":"OKLCH","l":0.6,"c":0.1,"h":250,"alpha":0.5}
+//	{"type":"Spot","ink":"PANTONE 185 C","book":"PANTONE+ Solid Coated","color":{...}}
+//
+// Every color type implements json.Marshaler with this encoding. LAB64 and LCH
+// colors add their reference white unless it is D65, AlphaColor adds "alpha" to its
+// color's object, and SpaceRGB colors are tagged with the name of their space, which
+// must be one of the standard spaces found by LookupRGBSpace.
+func MarshalJSON(c Color) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeColorJSON(&buf, c); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a color encoded by MarshalJSON, returning a value of the
+// type it was encoded from.
+func UnmarshalJSON(data []byte) (Color, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid color JSON: %w", err)
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("invalid color JSON: null")
+	}
+
+	var tag string
+	if err := json.Unmarshal(fields["type"], &tag); err != nil || tag == "" {
+		return nil, fmt.Errorf("invalid color JSON: missing type")
+	}
+
+	var c Color
+	switch {
+	case strings.EqualFold(tag, "Spot"):
+		var spot struct {
+			Ink   string          `json:"ink"`
+			Book  string          `json:"book"`
+			Color json.RawMessage `json:"color"`
+		}
+		if err := json.Unmarshal(data, &spot); err != nil {
+			return nil, fmt.Errorf("invalid Spot color JSON: %w", err)
+		}
+		alternate, err := UnmarshalJSON(spot.Color)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Spot color JSON: %w", err)
+		}
+		c = NewSpot(spot.Ink, spot.Book, alternate)
+
+	case strings.EqualFold(tag, "Spectral"):
+		var reflectance []float64
+		if err := json.Unmarshal(fields["reflectance"], &reflectance); err != nil {
+			return nil, fmt.Errorf("invalid Spectral color JSON: reflectance: %w", err)
+		}
+		var err error
+		if c, err = buildColor(tag, reflectance); err != nil {
+			return nil, err
+		}
+
+	default:
+		codec, ok := lookupCodec(tag)
+		if !ok {
+			return nil, fmt.Errorf("unknown color type: %s", tag)
+		}
+		values := make([]float64, len(codec.fields), len(codec.fields)+3)
+		for i, name := range codec.fields {
+			raw, ok := fields[name]
+			if !ok {
+				return nil, fmt.Errorf("invalid %s color JSON: missing %q", tag, name)
+			}
+			if err := json.Unmarshal(raw, &values[i]); err != nil {
+				return nil, fmt.Errorf("invalid %s color JSON: %s: %w", tag, name, err)
+			}
+		}
+		if raw, ok := fields["white"]; ok {
+			var white [3]float64
+			if err := json.Unmarshal(raw, &white); err != nil {
+				return nil, fmt.Errorf("invalid %s color JSON: white: %w", tag, err)
+			}
+			values = append(values, white[:]...)
+		}
+		var err error
+		if c, err = buildColor(tag, values); err != nil {
+			return nil, err
+		}
+	}
+
+	if raw, ok := fields["alpha"]; ok {
+		var alpha float64
+		if err := json.Unmarshal(raw, &alpha); err != nil {
+			return nil, fmt.Errorf("invalid color JSON: alpha: %w", err)
+		}
+		c = WithAlpha(c, alpha)
+	}
+	return c, nil
+}
+
+// MarshalText encodes any color of this package as text, its type followed by its
+// components, which UnmarshalText decodes back to exactly the same value:
+//
+//	RGB(255, 0, 0)
+//	LAB64(50, 20, -30, 0.96422, 1, 0.82521)
+//	DisplayP3(1, 0, 0)
+//	OKLCH(0.6, 0.1, 250) / 0.5
+//	Spot("PANTONE 185 C", "PANTONE+ Solid Coated", LAB64(...))
+//
+// Every color type implements encoding.TextMarshaler with this encoding, with the
+// same types and components as MarshalJSON.
+func MarshalText(c Color) ([]byte, error) {
+	var sb strings.Builder
+	if err := writeColorText(&sb, c); err != nil {
+		return nil, err
+	}
+	return []byte(sb.String()), nil
+}
+
+// UnmarshalText decodes a color encoded by MarshalText, returning a value of the
+// type it was encoded from.
+func UnmarshalText(text []byte) (Color, error) {
+	s := strings.TrimSpace(string(text))
+
+	// A trailing "/ alpha" follows the closing parenthesis
+	end := strings.LastIndexByte(s, ')')
+	if end < 0 {
+		return nil, fmt.Errorf("invalid color text %q: missing closing parenthesis", s)
+	}
+	if rest := strings.TrimSpace(s[end+1:]); rest != "" {
+		alphaText, ok := strings.CutPrefix(rest, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid color text %q: unexpected %q", s, rest)
+		}
+		alpha, err := strconv.ParseFloat(strings.TrimSpace(alphaText), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid color text %q: alpha: %w", s, err)
+		}
+		c, err := UnmarshalText([]byte(s[:end+1]))
+		if err != nil {
+			return nil, err
+		}
+		return WithAlpha(c, alpha), nil
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		return nil, fmt.Errorf("invalid color text %q: missing opening parenthesis", s)
+	}
+	tag, body := strings.TrimSpace(s[:open]), s[open+1:end]
+
+	if strings.EqualFold(tag, "Spot") {
+		ink, rest, err := cutQuoted(body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid color text %q: ink: %w", s, err)
+		}
+		book, rest, err := cutQuoted(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid color text %q: book: %w", s, err)
+		}
+		alternate, err := UnmarshalText([]byte(rest))
+		if err != nil {
+			return nil, err
+		}
+		return NewSpot(ink, book, alternate), nil
+	}
+
+	var values []float64
+	for field := range strings.SplitSeq(body, ",") {
+		v, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid color text %q: %w", s, err)
+		}
+		values = append(values, v)
+	}
+	return buildColor(tag, values)
+}
+
+// colorCodec describes how a color type is encoded.
+type colorCodec struct {
+	tag    string                           // Type tag, such as "RGB64"
+	fields []string                         // JSON field names of the components
+	white  bool                             // Components may be followed by a reference white
+	build  func(v []float64) (Color, error) // Creates the color from its components
+}
+
+// colorCodecs holds the codecs of the color types, except SpaceRGB, Spot and AlphaColor.
+var colorCodecs = []colorCodec{
+	{"RGB", []string{"r", "g", "b"}, false, func(v []float64) (Color, error) {
+		if err := wholeComponents(v, 0, 255); err != nil {
+			return nil, err
+		}
+		return RGB{uint8(v[0]), uint8(v[1]), uint8(v[2])}, nil
+	}},
+	{"CMYK", []string{"c", "m", "y", "k"}, false, func(v []float64) (Color, error) {
+		if err := wholeComponents(v, 0, 100); err != nil {
+			return nil, err
+		}
+		return CMYK{uint8(v[0]), uint8(v[1]), uint8(v[2]), uint8(v[3])}, nil
+	}},
+	{"LAB", []string{"l", "a", "b"}, false, func(v []float64) (Color, error) {
+		if err := wholeComponents(v, -128, 127); err != nil {
+			return nil, err
+		}
+		return LAB{int8(v[0]), int8(v[1]), int8(v[2])}, nil
+	}},
+	{"HSB", []string{"h", "s", "b"}, false, func(v []float64) (Color, error) {
+		if err := wholeComponents(v[:1], 0, 359); err != nil {
+			return nil, err
+		}
+		if err := wholeComponents(v[1:], 0, 100); err != nil {
+			return nil, err
+		}
+		return HSB{uint16(v[0]), uint8(v[1]), uint8(v[2])}, nil
+	}},
+	{"RGB64", []string{"r", "g", "b"}, false, func(v []float64) (Color, error) {
+		return RGB64{v[0], v[1], v[2]}, nil
+	}},
+	{"CMYK64", []string{"c", "m", "y", "k"}, false, func(v []float64) (Color, error) {
+		return CMYK64{v[0], v[1], v[2], v[3]}, nil
+	}},
+	{"LAB64", []string{"l", "a", "b"}, true, func(v []float64) (Color, error) {
+		return LAB64{v[0], v[1], v[2], whiteFrom(v[3:])}, nil
+	}},
+	{"HSB64", []string{"h", "s", "b"}, false, func(v []float64) (Color, error) {
+		return HSB64{v[0], v[1], v[2]}, nil
+	}},
+	{"XYZ", []string{"x", "y", "z"}, false, func(v []float64) (Color, error) {
+		return XYZ{v[0], v[1], v[2]}, nil
+	}},
+	{"Gray", []string{"y"}, false, func(v []float64) (Color, error) {
+		return Gray{v[0]}, nil
+	}},
+	{"HSL", []string{"h", "s", "l"}, false, func(v []float64) (Color, error) {
+		return HSL{v[0], v[1], v[2]}, nil
+	}},
+	{"LCH", []string{"l", "c", "h"}, true, func(v []float64) (Color, error) {
+		return LCH{v[0], v[1], v[2], whiteFrom(v[3:])}, nil
+	}},
+	{"OKLab", []string{"l", "a", "b"}, false, func(v []float64) (Color, error) {
+		return OKLab{v[0], v[1], v[2]}, nil
+	}},
+	{"OKLCH", []string{"l", "c", "h"}, false, func(v []float64) (Color, error) {
+		return OKLCH{v[0], v[1], v[2]}, nil
+	}},
+}
+
+// rgbCodec returns the codec of colors in a standard RGB working space.
+func rgbCodec(space *RGBSpace) colorCodec {
+	return colorCodec{space.Name, []string{"r", "g", "b"}, false, func(v []float64) (Color, error) {
+		return SpaceRGB{v[0], v[1], v[2], space}, nil
+	}}
+}
+
+// lookupCodec returns the codec for a type tag, matching case-insensitively. Tags
+// that are not color types are looked up as RGB working spaces.
+func lookupCodec(tag string) (colorCodec, bool) {
+	for _, codec := range colorCodecs {
+		if strings.EqualFold(codec.tag, tag) {
+			return codec, true
+		}
+	}
+	if space, ok := LookupRGBSpace(tag); ok {
+		return rgbCodec(space), true
+	}
+	return colorCodec{}, false
+}
+
+// buildColor creates a color from its type tag and components.
+func buildColor(tag string, values []float64) (Color, error) {
+	if strings.EqualFold(tag, "Spectral") {
+		if len(values) != SpectralSamples {
+			return nil, fmt.Errorf("invalid Spectral color: %d reflectance values (must be %d)", len(values), SpectralSamples)
+		}
+		var c Spectral
+		copy(c.Reflectance[:], values)
+		return c, nil
+	}
+
+	codec, ok := lookupCodec(tag)
+	if !ok {
+		return nil, fmt.Errorf("unknown color type: %s", tag)
+	}
+	if len(values) != len(codec.fields) && !(codec.white && len(values) == len(codec.fields)+3) {
+		return nil, fmt.Errorf("invalid %s color: %d components (must be %d: %s)",
+			codec.tag, len(values), len(codec.fields), strings.Join(codec.fields, ", "))
+	}
+	c, err := codec.build(values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s color: %w", codec.tag, err)
+	}
+	return c, nil
+}
+
+// colorComponents returns the type tag, codec and components of a color, with any
+// reference white other than D65 appended.
+func colorComponents(c Color) (colorCodec, []float64, error) {
+	var tag string
+	var values []float64
+	switch c := c.(type) {
+	case RGB:
+		tag, values = "RGB", []float64{float64(c.R), float64(c.G), float64(c.B)}
+	case CMYK:
+		tag, values = "CMYK", []float64{float64(c.C), float64(c.M), float64(c.Y), float64(c.K)}
+	case LAB:
+		tag, values = "LAB", []float64{float64(c.L), float64(c.A), float64(c.B)}
+	case HSB:
+		tag, values = "HSB", []float64{float64(c.H), float64(c.S), float64(c.B)}
+	case RGB64:
+		tag, values = "RGB64", []float64{c.R, c.G, c.B}
+	case CMYK64:
+		tag, values = "CMYK64", []float64{c.C, c.M, c.Y, c.K}
+	case LAB64:
+		tag, values = "LAB64", []float64{c.L, c.A, c.B}
+		if white := c.WhitePoint(); white != D65 {
+			values = append(values, white.X, white.Y, white.Z)
+		}
+	case HSB64:
+		tag, values = "HSB64", []float64{c.H, c.S, c.B}
+	case XYZ:
+		tag, values = "XYZ", []float64{c.X, c.Y, c.Z}
+	case Gray:
+		tag, values = "Gray", []float64{c.Y}
+	case HSL:
+		tag, values = "HSL", []float64{c.H, c.S, c.L}
+	case LCH:
+		tag, values = "LCH", []float64{c.L, c.C, c.H}
+		if white := c.WhitePoint(); white != D65 {
+			values = append(values, white.X, white.Y, white.Z)
+		}
+	case OKLab:
+		tag, values = "OKLab", []float64{c.L, c.A, c.B}
+	case OKLCH:
+		tag, values = "OKLCH", []float64{c.L, c.C, c.H}
+	case SpaceRGB:
+		if space, ok := LookupRGBSpace(c.Space.Name); !ok || space != c.Space {
+			return colorCodec{}, nil, fmt.Errorf("cannot encode color in RGB space %s: not a standard space", c.Space.Name)
+		}
+		return rgbCodec(c.Space), []float64{c.R, c.G, c.B}, nil
+	default:
+		return colorCodec{}, nil, fmt.Errorf("cannot encode color type %T", c)
+	}
+
+	codec, _ := lookupCodec(tag)
+	return codec, values, nil
+}
+
+// writeColorJSON writes the JSON object of a color.
+func writeColorJSON(buf *bytes.Buffer, c Color) error {
+	alpha, hasAlpha := 0.0, false
+	if ac, ok := c.(AlphaColor); ok {
+		c, alpha, hasAlpha = ac.Color, ac.Alpha, true
+	}
+
+	type field struct {
+		key   string
+		value any
+	}
+	var fields []field
+
+	switch c := c.(type) {
+	case SpotColor:
+		var alternate bytes.Buffer
+		if err := writeColorJSON(&alternate, c.Color); err != nil {
+			return err
+		}
+		fields = []field{{"type", "Spot"}, {"ink", c.Ink}, {"book", c.Book}, {"color", json.RawMessage(alternate.Bytes())}}
+
+	case Spectral:
+		fields = []field{{"type", "Spectral"}, {"reflectance", c.Reflectance}}
+
+	default:
+		codec, values, err := colorComponents(c)
+		if err != nil {
+			return err
+		}
+		fields = []field{{"type", codec.tag}}
+		for i, name := range codec.fields {
+			fields = append(fields, field{name, values[i]})
+		}
+		if len(values) > len(codec.fields) {
+			fields = append(fields, field{"white", values[len(codec.fields):]})
+		}
+	}
+	if hasAlpha {
+		fields = append(fields, field{"alpha", alpha})
+	}
+
+	buf.WriteByte('{')
+	for i, f := range fields {
+		data, err := json.Marshal(f.value)
+		if err != nil {
+			return fmt.Errorf("cannot encode color %s: %w", f.key, err)
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(buf, "%q:%s", f.key, data)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeColorText writes the text form of a color.
+func writeColorText(sb *strings.Builder, c Color) error {
+	alpha, hasAlpha := 0.0, false
+	if ac, ok := c.(AlphaColor); ok {
+		c, alpha, hasAlpha = ac.Color, ac.Alpha, true
+	}
+
+	switch c := c.(type) {
+	case SpotColor:
+		fmt.Fprintf(sb, "Spot(%s, %s, ", strconv.Quote(c.Ink), strconv.Quote(c.Book))
+		if err := writeColorText(sb, c.Color); err != nil {
+			return err
+		}
+		sb.WriteString(")")
+
+	case Spectral:
+		writeComponents(sb, "Spectral", c.Reflectance[:])
+
+	default:
+		codec, values, err := colorComponents(c)
+		if err != nil {
+			return err
+		}
+		writeComponents(sb, codec.tag, values)
+	}
+
+	if hasAlpha {
+		fmt.Fprintf(sb, " / %s", strconv.FormatFloat(alpha, 'g', -1, 64))
+	}
+	return nil
+}
+
+// writeComponents writes a tag and its components as "tag(v1, v2, ...)".
+func writeComponents(sb *strings.Builder, tag string, values []float64) {
+	sb.WriteString(tag)
+	sb.WriteByte('(')
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	}
+	sb.WriteByte(')')
+}
+
+// wholeComponents checks that every component is a whole number from lo to hi.
+func wholeComponents(values []float64, lo, hi float64) error {
+	for _, v := range values {
+		if v != math.Trunc(v) || v < lo || v > hi {
+			return fmt.Errorf("component %v is not a whole number from %v to %v", v, lo, hi)
+		}
+	}
+	return nil
+}
+
+// whiteFrom returns the reference white stored after a color's components, or the
+// zero value, which means D65.
+func whiteFrom(values []float64) XYZ {
+	if len(values) < 3 {
+		return XYZ{}
+	}
+	if white := (XYZ{values[0], values[1], values[2]}); white != D65 {
+		return white
+	}
+	return XYZ{}
+}
+
+// cutQuoted cuts a quoted string and the comma after it from the start of s.
+func cutQuoted(s string) (value, rest string, err error) {
+	s = strings.TrimSpace(s)
+	quoted, err := strconv.QuotedPrefix(s)
+	if err != nil {
+		return "", "", err
+	}
+	value, _ = strconv.Unquote(quoted)
+	rest, ok := strings.CutPrefix(strings.TrimSpace(s[len(quoted):]), ",")
+	if !ok {
+		return "", "", fmt.Errorf("missing comma after %s", quoted)
+	}
+	return value, rest, nil
+}
+
+// unmarshalAs decodes data and stores the color in dst if it has dst's type.
+func unmarshalAs[T Color](data []byte, decode func([]byte) (Color, error), dst *T) error {
+	c, err := decode(data)
+	if err != nil {
+		return err
+	}
+	v, ok := c.(T)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %T into %T", c, *dst)
+	}
+	*dst = v
+	return nil
+}
+
+func (c RGB) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c RGB) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *RGB) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *RGB) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c CMYK) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c CMYK) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *CMYK) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *CMYK) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c LAB) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c LAB) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *LAB) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *LAB) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c HSB) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c HSB) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *HSB) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *HSB) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c RGB64) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c RGB64) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *RGB64) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *RGB64) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c CMYK64) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c CMYK64) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *CMYK64) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *CMYK64) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c LAB64) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c LAB64) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *LAB64) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *LAB64) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c HSB64) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c HSB64) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *HSB64) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *HSB64) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c XYZ) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c XYZ) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *XYZ) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *XYZ) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c Gray) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c Gray) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *Gray) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *Gray) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c HSL) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c HSL) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *HSL) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *HSL) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c LCH) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c LCH) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *LCH) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *LCH) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c OKLab) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c OKLab) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *OKLab) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *OKLab) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c OKLCH) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c OKLCH) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *OKLCH) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *OKLCH) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c SpaceRGB) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c SpaceRGB) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *SpaceRGB) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *SpaceRGB) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c Spectral) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c Spectral) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *Spectral) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *Spectral) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c SpotColor) MarshalJSON() ([]byte, error)     { return MarshalJSON(c) }
+func (c SpotColor) MarshalText() ([]byte, error)     { return MarshalText(c) }
+func (c *SpotColor) UnmarshalJSON(data []byte) error { return unmarshalAs(data, UnmarshalJSON, c) }
+func (c *SpotColor) UnmarshalText(text []byte) error { return unmarshalAs(text, UnmarshalText, c) }
+
+func (c AlphaColor) MarshalJSON() ([]byte, error) { return MarshalJSON(c) }
+func (c AlphaColor) MarshalText() ([]byte, error) { return MarshalText(c) }
+
+// UnmarshalJSON decodes a color encoded by MarshalJSON. A color without alpha is
+// stored as fully opaque.
+func (c *AlphaColor) UnmarshalJSON(data []byte) error {
+	return unmarshalAlpha(data, UnmarshalJSON, c)
+}
+
+// UnmarshalText decodes a color encoded by MarshalText. A color without alpha is
+// stored as fully opaque.
+func (c *AlphaColor) UnmarshalText(text []byte) error {
+	return unmarshalAlpha(text, UnmarshalText, c)
+}
+
+// unmarshalAlpha decodes data and stores the color in dst, adding full opacity if
+// it has no alpha.
+func unmarshalAlpha(data []byte, decode func([]byte) (Color, error), dst *AlphaColor) error {
+	c, err := decode(data)
+	if err != nil {
+		return err
+	}
+	*dst = WithAlpha(c, AlphaOf(c))
+	return nil
+}
@@ -0,0 +1,209 @@
+package color
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func marshalTestColors() map[string]Color {
+	var spectral Spectral
+	for i := range spectral.Reflectance {
+		spectral.Reflectance[i] = float64(i) / SpectralSamples
+	}
+
+	return map[string]Color{
+		"RGB":             NewRGB(255, 128, 0),
+		"CMYK":            NewCMYK(100, 0, 50, 10),
+		"LAB":             NewLAB(50, -20, 30),
+		"HSB":             NewHSB(240, 100, 50),
+		"RGB64":           NewRGB64(1, 0.5, 0.1),
+		"CMYK64":          NewCMYK64(0.125, 0, 1, 1.0/3),
+		"LAB64":           NewLAB64(53.24, 80.09, 67.2),
+		"LAB64 D50":       NewLAB64WithWhite(53.24, 80.09, 67.2, D50),
+		"HSB64":           NewHSB64(210.5, 0.8, 1),
+		"XYZ":             D65,
+		"Gray":            NewGray(0.25),
+		"HSL":             NewHSL(120, 0.5, 0.25),
+		"LCH":             NewLCH(50, 40, 300),
+		"LCH D50":         NewLCHWithWhite(50, 40, 300, D50),
+		"OKLab":           NewOKLab(0.628, 0.2249, 0.1258),
+		"OKLCH":           NewOKLCH(0.628, 0.2577, 29.23),
+		"DisplayP3":       DisplayP3.New(1, 0, 0.1),
+		"sRGB space":      SRGB.New(0, 1, 0),
+		"Spectral":        spectral,
+		"Alpha":           WithAlpha(NewOKLCH(0.6, 0.1, 250), 0.5),
+		"Spot":            NewSpot(`PANTONE "185" C`, "PANTONE+, Solid Coated", NewLAB64(47, 72, 42)),
+		"Spot with alpha": WithAlpha(NewSpot("Gold", "", NewCMYK(0, 20, 60, 20)), 0.25),
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	for name, c := range marshalTestColors() {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(c)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			// Decode as the concrete type
+			decoded := reflect.New(reflect.TypeOf(c))
+			if err := json.Unmarshal(data, decoded.Interface()); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if got := decoded.Elem().Interface(); !reflect.DeepEqual(got, c) {
+				t.Errorf("json.Unmarshal(%s) = %#v, want %#v", data, got, c)
+			}
+
+			// Decode as any color
+			got, err := UnmarshalJSON(data)
+			if err != nil {
+				t.Fatalf("UnmarshalJSON(%s) error = %v", data, err)
+			}
+			if !reflect.DeepEqual(got, c) {
+				t.Errorf("UnmarshalJSON(%s) = %#v, want %#v", data, got, c)
+			}
+		})
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	for name, c := range marshalTestColors() {
+		t.Run(name, func(t *testing.T) {
+			text, err := c.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+
+			decoded := reflect.New(reflect.TypeOf(c))
+			if err := decoded.Interface().(interface{ UnmarshalText([]byte) error }).UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%s) error = %v", text, err)
+			}
+			if got := decoded.Elem().Interface(); !reflect.DeepEqual(got, c) {
+				t.Errorf("UnmarshalText(%s) = %#v, want %#v", text, got, c)
+			}
+
+			got, err := UnmarshalText(text)
+			if err != nil {
+				t.Fatalf("UnmarshalText(%s) error = %v", text, err)
+			}
+			if !reflect.DeepEqual(got, c) {
+				t.Errorf("UnmarshalText(%s) = %#v, want %#v", text, got, c)
+			}
+		})
+	}
+}
+
+func TestMarshalFormat(t *testing.T) {
+	tests := map[string]struct {
+		color    Color
+		wantJSON string
+		wantText string
+	}{
+		"RGB": {
+			NewRGB(255, 0, 0),
+			`{"type":"RGB","r":255,"g":0,"b":0}`,
+			"RGB(255, 0, 0)",
+		},
+		"LAB64 D50": {
+			NewLAB64WithWhite(50, 20, -30, D50),
+			`{"type":"LAB64","l":50,"a":20,"b":-30,"white":[0.96422,1,0.82521]}`,
+			"LAB64(50, 20, -30, 0.96422, 1, 0.82521)",
+		},
+		"DisplayP3": {
+			DisplayP3.New(1, 0, 0),
+			`{"type":"DisplayP3","r":1,"g":0,"b":0}`,
+			"DisplayP3(1, 0, 0)",
+		},
+		"Alpha": {
+			WithAlpha(NewOKLCH(0.6, 0.1, 250), 0.5),
+			`{"type":"OKLCH","l":0.6,"c":0.1,"h":250,"alpha":0.5}`,
+			"OKLCH(0.6, 0.1, 250) / 0.5",
+		},
+		"Spot": {
+			NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", NewCMYK(0, 93, 79, 0)),
+			`{"type":"Spot","ink":"PANTONE 185 C","book":"PANTONE+ Solid Coated","color":{"type":"CMYK","c":0,"m":93,"y":79,"k":0}}`,
+			`Spot("PANTONE 185 C", "PANTONE+ Solid Coated", CMYK(0, 93, 79, 0))`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := MarshalJSON(tt.color)
+			if err != nil || string(data) != tt.wantJSON {
+				t.Errorf("MarshalJSON() = %s, %v; want %s", data, err, tt.wantJSON)
+			}
+			text, err := MarshalText(tt.color)
+			if err != nil || string(text) != tt.wantText {
+				t.Errorf("MarshalText() = %s, %v; want %s", text, err, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	jsonTests := map[string]string{
+		"Not an object":     `"RGB(255, 0, 0)"`,
+		"Null":              `null`,
+		"Missing type":      `{"r":255,"g":0,"b":0}`,
+		"Unknown type":      `{"type":"CMY","c":1,"m":0,"y":0}`,
+		"Missing component": `{"type":"RGB","r":255,"g":0}`,
+		"Out of range":      `{"type":"RGB","r":256,"g":0,"b":0}`,
+		"Fractional":        `{"type":"CMYK","c":50.5,"m":0,"y":0,"k":0}`,
+		"Short spectrum":    `{"type":"Spectral","reflectance":[0.5,0.5]}`,
+		"Bad spot":          `{"type":"Spot","ink":"Gold","color":{"type":"Gold"}}`,
+	}
+	for name, data := range jsonTests {
+		t.Run("JSON "+name, func(t *testing.T) {
+			if c, err := UnmarshalJSON([]byte(data)); err == nil {
+				t.Errorf("UnmarshalJSON(%s) = %v, want error", data, c)
+			}
+		})
+	}
+
+	textTests := map[string]string{
+		"Empty":           "",
+		"No parentheses":  "red",
+		"Unknown type":    "CMY(1, 0, 0)",
+		"Too few":         "RGB64(1, 0)",
+		"Not a number":    "RGB64(1, 0, x)",
+		"Bad alpha":       "RGB(255, 0, 0) / half",
+		"Trailing text":   "RGB(255, 0, 0) red",
+		"Unquoted ink":    "Spot(Gold, \"\", RGB(255, 215, 0))",
+		"Missing comma":   "Spot(\"Gold\" \"\", RGB(255, 215, 0))",
+		"White too short": "LAB64(50, 20, -30, 0.9642)",
+	}
+	for name, text := range textTests {
+		t.Run("Text "+name, func(t *testing.T) {
+			if c, err := UnmarshalText([]byte(text)); err == nil {
+				t.Errorf("UnmarshalText(%q) = %v, want error", text, c)
+			}
+		})
+	}
+
+	t.Run("Wrong type", func(t *testing.T) {
+		var rgb RGB
+		err := json.Unmarshal([]byte(`{"type":"LAB64","l":50,"a":0,"b":0}`), &rgb)
+		if err == nil || !strings.Contains(err.Error(), "color.LAB64 into color.RGB") {
+			t.Errorf("json.Unmarshal() error = %v, want a type mismatch", err)
+		}
+	})
+
+	t.Run("Custom RGB space", func(t *testing.T) {
+		space := NewRGBSpace("Custom", SRGB.Red, SRGB.Green, SRGB.Blue, D65, srgbToLinear, linearToSRGB)
+		if _, err := json.Marshal(space.New(1, 0, 0)); err == nil {
+			t.Errorf("json.Marshal() of a color in a custom space succeeded, want error")
+		}
+	})
+}
+
+func TestAlphaColorUnmarshalOpaque(t *testing.T) {
+	var c AlphaColor
+	if err := c.UnmarshalText([]byte("RGB(255, 0, 0)")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if want := WithAlpha(NewRGB(255, 0, 0), 1); c != want {
+		t.Errorf("UnmarshalText() = %v, want %v", c, want)
+	}
+}
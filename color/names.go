@@ -0,0 +1,179 @@
+package color
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// NameSet is a dictionary of reference color names, used to give colors readable
+// names. The built-in sets are CSSNames, X11Names and ExtendedNames.
+type NameSet struct {
+	Name    string // Name of the set, such as "x11"
+	entries []nameEntry
+	index   map[string]int // Normalized name to entry
+}
+
+// nameEntry is a named reference color with its Lab value cached for lookups.
+type nameEntry struct {
+	name string
+	rgb  RGB
+	lab  LAB64
+}
+
+//go:embed names/x11.txt
+var x11NameData string
+
+//go:embed names/extended.txt
+var extendedNameData string
+
+var (
+	cssNameSet = sync.OnceValue(func() *NameSet {
+		keys := make([]string, 0, len(cssNamedColors))
+		for key := range cssNamedColors {
+			// The British spellings duplicate the gray colors
+			if !strings.Contains(key, "grey") {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		entries := make([]nameEntry, len(keys))
+		for i, key := range keys {
+			entries[i] = nameEntry{name: key, rgb: cssNamedColors[key]}
+		}
+		return newNameSet("css", entries)
+	})
+
+	x11NameSet = sync.OnceValue(func() *NameSet {
+		return newNameSet("x11", mustParseNameList(x11NameData))
+	})
+
+	extendedNameSet = sync.OnceValue(func() *NameSet {
+		entries := mustParseNameList(extendedNameData)
+		for _, entry := range X11Names().entries {
+			// Numbered X11 variants, such as "Antique White 3", are not readable names
+			if last := entry.name[strings.LastIndexByte(entry.name, ' ')+1:]; !isDigits(last) {
+				entries = append(entries, entry)
+			}
+		}
+		return newNameSet("extended", entries)
+	})
+)
+
+// CSSNames returns the CSS Color Level 4 named colors, named by their CSS keywords
+// such as "rebeccapurple".
+func CSSNames() *NameSet {
+	return cssNameSet()
+}
+
+// X11Names returns the X11 color names from X.Org's rgb.txt, including the numbered
+// variants such as "Antique White 3" and the grays "Gray 0" to "Gray 100".
+func X11Names() *NameSet {
+	return x11NameSet()
+}
+
+// ExtendedNames returns a dictionary of common pigment, dye and design color names,
+// such as "Burnt Sienna" and "Prussian Blue", together with the X11 names without
+// numbered variants.
+func ExtendedNames() *NameSet {
+	return extendedNameSet()
+}
+
+// LookupNameSet returns the built-in name set with the given name: "css", "x11" or
+// "extended".
+func LookupNameSet(name string) (*NameSet, bool) {
+	switch strings.ToLower(name) {
+	case "css":
+		return CSSNames(), true
+	case "x11":
+		return X11Names(), true
+	case "extended":
+		return ExtendedNames(), true
+	default:
+		return nil, false
+	}
+}
+
+// Len returns the number of names in the set.
+func (s *NameSet) Len() int {
+	return len(s.entries)
+}
+
+// Lookup returns the color with the given name. Matching ignores case and spaces, so
+// "ghostwhite" finds "Ghost White".
+func (s *NameSet) Lookup(name string) (RGB, bool) {
+	i, ok := s.index[normalizeColorName(name)]
+	if !ok {
+		return RGB{}, false
+	}
+	return s.entries[i].rgb, true
+}
+
+// Nearest returns the name in the set closest to c, and the CIEDE2000 difference
+// between them. Alpha is ignored.
+func (s *NameSet) Nearest(c Color) (string, float64) {
+	lab := c.ToLAB64()
+
+	best, bestDelta := "", -1.0
+	for _, entry := range s.entries {
+		if d := deltaE2000(entry.lab, lab); bestDelta < 0 || d < bestDelta {
+			best, bestDelta = entry.name, d
+		}
+	}
+	return best, bestDelta
+}
+
+func newNameSet(name string, entries []nameEntry) *NameSet {
+	s := &NameSet{Name: name, index: make(map[string]int, len(entries))}
+	for _, entry := range entries {
+		key := normalizeColorName(entry.name)
+		if _, ok := s.index[key]; ok {
+			continue
+		}
+		entry.lab = entry.rgb.ToLAB64()
+		s.index[key] = len(s.entries)
+		s.entries = append(s.entries, entry)
+	}
+	return s
+}
+
+// mustParseNameList parses an embedded name list of "RRGGBB<tab>Name" lines, skipping
+// blank lines and "#" comments.
+func mustParseNameList(data string) []nameEntry {
+	var entries []nameEntry
+	for line := range strings.Lines(data) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		hex, name, ok := strings.Cut(line, "\t")
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if !ok || err != nil || len(hex) != 6 {
+			panic(fmt.Sprintf("color: invalid name list entry %q", line))
+		}
+		entries = append(entries, nameEntry{name: name, rgb: NewRGB(uint8(v>>16), uint8(v>>8), uint8(v))})
+	}
+	return entries
+}
+
+// normalizeColorName folds a color name for lookups.
+func normalizeColorName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package color
+
+import "testing"
+
+func TestNameSets(t *testing.T) {
+	tests := map[string]struct {
+		set     *NameSet
+		minLen  int
+		name    string
+		want    RGB
+		missing string
+	}{
+		"CSS":      {CSSNames(), 140, "RebeccaPurple", NewRGB(102, 51, 153), "Burnt Sienna"},
+		"X11":      {X11Names(), 500, "antique white 3", NewRGB(205, 192, 176), "rebeccapurple"},
+		"Extended": {ExtendedNames(), 400, "Burnt Sienna", NewRGB(0xE9, 0x74, 0x51), "Gray 50"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.set.Len() < tt.minLen {
+				t.Errorf("Len() = %d, want at least %d", tt.set.Len(), tt.minLen)
+			}
+			if got, ok := tt.set.Lookup(tt.name); !ok || got != tt.want {
+				t.Errorf("Lookup(%q) = %v, %v; want %v", tt.name, got, ok, tt.want)
+			}
+			if _, ok := tt.set.Lookup(tt.missing); ok {
+				t.Errorf("Lookup(%q) found a color", tt.missing)
+			}
+		})
+	}
+
+	// X11 and CSS disagree on some names
+	if green, _ := X11Names().Lookup("green"); green != NewRGB(0, 255, 0) {
+		t.Errorf("X11 green = %v, want RGB(0, 255, 0)", green)
+	}
+}
+
+func TestNameSetNearest(t *testing.T) {
+	tests := map[string]struct {
+		set       *NameSet
+		color     Color
+		want      string
+		maxDeltaE float64
+	}{
+		"Exact CSS":        {CSSNames(), NewRGB(255, 99, 71), "tomato", 0.001},
+		"Near CSS":         {CSSNames(), NewRGB(250, 128, 110), "salmon", 3},
+		"CSS gray":         {CSSNames(), NewRGB(128, 128, 128), "gray", 0.001},
+		"Exact X11":        {X11Names(), NewRGB(238, 223, 204), "Antique White 2", 0.001},
+		"Extended pigment": {ExtendedNames(), NewRGB(0, 50, 85), "Prussian Blue", 3},
+		"Extended X11":     {ExtendedNames(), NewRGB(255, 250, 250), "Snow", 0.001},
+		"Through alpha":    {CSSNames(), WithAlpha(NewRGB(0, 0, 255), 0.3), "blue", 0.001},
+		"Any color space":  {CSSNames(), NewCMYK(0, 0, 0, 100), "black", 0.5},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, d := tt.set.Nearest(tt.color)
+			if got != tt.want || d > tt.maxDeltaE {
+				t.Errorf("Nearest() = %q (ΔE %.3f), want %q within %.3f", got, d, tt.want, tt.maxDeltaE)
+			}
+		})
+	}
+}
+
+func TestLookupNameSet(t *testing.T) {
+	for _, name := range []string{"css", "X11", "Extended"} {
+		if set, ok := LookupNameSet(name); !ok || set == nil {
+			t.Errorf("LookupNameSet(%q) not found", name)
+		}
+	}
+	if _, ok := LookupNameSet("pantone"); ok {
+		t.Error("LookupNameSet(pantone) found a set")
+	}
+}
+
+func TestMustParseNameList(t *testing.T) {
+	entries := mustParseNameList("# Comment\n\nFF8800\tOrange Juice\n")
+	if len(entries) != 1 || entries[0].name != "Orange Juice" || entries[0].rgb != NewRGB(255, 136, 0) {
+		t.Errorf("mustParseNameList() = %+v", entries)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("mustParseNameList() did not panic on a bad entry")
+		}
+	}()
+	mustParseNameList("GG0000\tBad\n")
+}
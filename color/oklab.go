@@ -0,0 +1,184 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// OKLab represents a color in the OKLab perceptual color space.
+// L is in the range 0-1; A and B are unbounded but typically -0.4 to 0.4.
+type OKLab struct {
+	L, A, B float64
+}
+
+// NewOKLab creates a new OKLab color with validation.
+func NewOKLab(l, a, b float64) OKLab {
+	return OKLab{
+		L: clamp(l, 0, 1),
+		A: a,
+		B: b,
+	}
+}
+
+func (c OKLab) String() string {
+	return fmt.Sprintf("OKLab(%.4f, %.4f, %.4f)", c.L, c.A, c.B)
+}
+
+func (c OKLab) ColorSpace() string {
+	return "OKLAB"
+}
+
+func (c OKLab) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c OKLab) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c OKLab) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c OKLab) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c OKLab) ToRGB64() RGB64 {
+	return c.ToXYZ().ToRGB64()
+}
+
+func (c OKLab) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c OKLab) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c OKLab) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c OKLab) ToXYZ() XYZ {
+	l, m, s := okLabToLMS.apply(c.L, c.A, c.B)
+	x, y, z := lmsToXYZ.apply(l*l*l, m*m*m, s*s*s)
+	return XYZ{X: x, Y: y, Z: z}
+}
+
+// ToOKLCH converts the color to the cylindrical OKLCH form.
+func (c OKLab) ToOKLCH() OKLCH {
+	h := 0.0
+	chroma := math.Hypot(c.A, c.B)
+	if chroma > achromaticThreshold {
+		h = normalizeHue(math.Atan2(c.B, c.A) * 180 / math.Pi)
+	}
+	return OKLCH{L: c.L, C: chroma, H: h}
+}
+
+// OKLCH represents a color in the cylindrical form of OKLab.
+// L is in the range 0-1; C is 0 or greater (typically up to 0.4); H is in degrees (0-360).
+type OKLCH struct {
+	L, C, H float64
+}
+
+// NewOKLCH creates a new OKLCH color with validation.
+func NewOKLCH(l, c, h float64) OKLCH {
+	return OKLCH{
+		L: clamp(l, 0, 1),
+		C: math.Max(c, 0),
+		H: normalizeHue(h),
+	}
+}
+
+func (c OKLCH) String() string {
+	return fmt.Sprintf("OKLCH(%.4f, %.4f, %.2f°)", c.L, c.C, c.H)
+}
+
+func (c OKLCH) ColorSpace() string {
+	return "OKLCH"
+}
+
+func (c OKLCH) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c OKLCH) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c OKLCH) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c OKLCH) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c OKLCH) ToRGB64() RGB64 {
+	return c.ToXYZ().ToRGB64()
+}
+
+func (c OKLCH) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c OKLCH) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c OKLCH) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c OKLCH) ToXYZ() XYZ {
+	return c.ToOKLab().ToXYZ()
+}
+
+// ToOKLab converts the color to the rectangular OKLab form.
+func (c OKLCH) ToOKLab() OKLab {
+	h := c.H * math.Pi / 180
+	return OKLab{L: c.L, A: c.C * math.Cos(h), B: c.C * math.Sin(h)}
+}
+
+// ToOKLab converts XYZ values to OKLab.
+func (c XYZ) ToOKLab() OKLab {
+	l, m, s := xyzToLMS.apply(c.X, c.Y, c.Z)
+	okL, okA, okB := lmsToOKLab.apply(math.Cbrt(l), math.Cbrt(m), math.Cbrt(s))
+	return OKLab{L: okL, A: okA, B: okB}
+}
+
+// ToOKLCH converts XYZ values to OKLCH.
+func (c XYZ) ToOKLCH() OKLCH {
+	return c.ToOKLab().ToOKLCH()
+}
+
+// achromaticThreshold is the chroma below which a color's hue is treated as undefined (0).
+const achromaticThreshold = 1e-8
+
+var (
+	// srgbToLMS converts linear sRGB to the OKLab cone response (Björn Ottosson).
+	// It is combined with the XYZ matrices below so that sRGB white maps to a = b = 0.
+	srgbToLMS = mat3{
+		{0.4122214708, 0.5363325363, 0.0514459929},
+		{0.2119034982, 0.6806995451, 0.1073969566},
+		{0.0883024619, 0.2817188376, 0.6299787005},
+	}
+
+	// xyzToLMS converts XYZ (D65) to the OKLab cone response.
+	xyzToLMS = srgbToLMS.mul(xyzToSRGB)
+
+	// lmsToXYZ converts the OKLab cone response to XYZ (D65).
+	lmsToXYZ = xyzToLMS.inverse()
+
+	// lmsToOKLab converts non-linear cone responses to OKLab, using the
+	// higher-precision coefficients from CSS Color 4.
+	lmsToOKLab = mat3{
+		{0.2104542683093140, 0.7936177747023054, -0.0040720430116193},
+		{1.9779985324311684, -2.4285922420485799, 0.4505937096174110},
+		{0.0259040424655478, 0.7827717124575296, -0.8086757549230774},
+	}
+
+	// okLabToLMS converts OKLab to non-linear cone responses.
+	okLabToLMS = lmsToOKLab.inverse()
+)
@@ -0,0 +1,103 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOKLab(t *testing.T) {
+	// Reference values from CSS Color Module Level 4
+	tests := map[string]struct {
+		rgb  RGB
+		want OKLab
+	}{
+		"White": {RGB{255, 255, 255}, OKLab{1, 0, 0}},
+		"Black": {RGB{0, 0, 0}, OKLab{0, 0, 0}},
+		"Red":   {RGB{255, 0, 0}, OKLab{0.62796, 0.22486, 0.12585}},
+		"Green": {RGB{0, 255, 0}, OKLab{0.86644, -0.23389, 0.17950}},
+		"Blue":  {RGB{0, 0, 255}, OKLab{0.45201, -0.03246, -0.31153}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.rgb.ToXYZ().ToOKLab()
+			const tolerance = 1e-4
+			if math.Abs(got.L-tt.want.L) > tolerance ||
+				math.Abs(got.A-tt.want.A) > tolerance ||
+				math.Abs(got.B-tt.want.B) > tolerance {
+				t.Errorf("ToOKLab() = %v, want %v", got, tt.want)
+			}
+
+			if back := got.ToRGB(); back != tt.rgb {
+				t.Errorf("OKLab.ToRGB() = %v, want %v", back, tt.rgb)
+			}
+		})
+	}
+}
+
+func TestOKLCH(t *testing.T) {
+	red := RGB{255, 0, 0}.ToXYZ().ToOKLCH()
+
+	const tolerance = 1e-3
+	if math.Abs(red.L-0.62796) > tolerance ||
+		math.Abs(red.C-0.25768) > tolerance ||
+		math.Abs(red.H-29.23) > 0.01 {
+		t.Errorf("ToOKLCH() = %v, want OKLCH(0.6280, 0.2577, 29.23°)", red)
+	}
+
+	if got := red.ToRGB(); got != (RGB{255, 0, 0}) {
+		t.Errorf("OKLCH.ToRGB() = %v, want RGB(255, 0, 0)", got)
+	}
+
+	// Achromatic colors have no hue
+	if gray := (RGB{128, 128, 128}).ToXYZ().ToOKLCH(); gray.H != 0 || gray.C > 1e-6 {
+		t.Errorf("Gray ToOKLCH() = %v, want zero chroma and hue", gray)
+	}
+}
+
+func TestOKLabString(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  string
+		space string
+	}{
+		"OKLab": {NewOKLab(0.62796, 0.22486, 0.12585), "OKLab(0.6280, 0.2249, 0.1258)", "OKLAB"},
+		"OKLCH": {NewOKLCH(0.62796, 0.25768, -330.77), "OKLCH(0.6280, 0.2577, 29.23°)", "OKLCH"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.color.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := tt.color.ColorSpace(); got != tt.space {
+				t.Errorf("ColorSpace() = %v, want %v", got, tt.space)
+			}
+		})
+	}
+}
+
+func TestOKLabRoundTrip(t *testing.T) {
+	original := NewRGB64(0.2, 0.4, 0.6)
+
+	tests := map[string]func(RGB64) RGB64{
+		"OKLab": func(c RGB64) RGB64 { return c.ToXYZ().ToOKLab().ToRGB64() },
+		"OKLCH": func(c RGB64) RGB64 { return c.ToXYZ().ToOKLCH().ToRGB64() },
+	}
+
+	for name, convert := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := original
+			for range 10 {
+				c = convert(c)
+			}
+
+			const tolerance = 1e-9
+			if math.Abs(c.R-original.R) > tolerance ||
+				math.Abs(c.G-original.G) > tolerance ||
+				math.Abs(c.B-original.B) > tolerance {
+				t.Errorf("RGB64 -> %s round trips drifted: %v -> %v", name, original, c)
+			}
+		})
+	}
+}
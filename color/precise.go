@@ -0,0 +1,372 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// RGB64 represents a color in RGB color space with float64 precision.
+// Components are in the range 0.0-1.0.
+type RGB64 struct {
+	R, G, B float64
+}
+
+// NewRGB64 creates a new high-precision RGB color with validation.
+func NewRGB64(r, g, b float64) RGB64 {
+	return RGB64{
+		R: clamp(r, 0, 1),
+		G: clamp(g, 0, 1),
+		B: clamp(b, 0, 1),
+	}
+}
+
+func (c RGB64) String() string {
+	return fmt.Sprintf("RGB(%.4f, %.4f, %.4f)", c.R, c.G, c.B)
+}
+
+func (c RGB64) ColorSpace() string {
+	return "RGB"
+}
+
+func (c RGB64) ToRGB() RGB {
+	return NewRGBFromFloat(c.R, c.G, c.B)
+}
+
+func (c RGB64) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c RGB64) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c RGB64) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c RGB64) ToRGB64() RGB64 {
+	return c
+}
+
+// ToCMYK64 separates the color into inks with maximum black generation and no ink
+// limit. Use a Separation for other strategies.
+func (c RGB64) ToCMYK64() CMYK64 {
+	return Separation{}.Separate(c)
+}
+
+func (c RGB64) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c RGB64) ToHSB64() HSB64 {
+	r := clamp(c.R, 0, 1)
+	g := clamp(c.G, 0, 1)
+	b := clamp(c.B, 0, 1)
+
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+	delta := max - min
+
+	var h, s float64
+	brightness := max
+
+	if delta != 0 {
+		s = delta / max
+
+		switch max {
+		case r:
+			h = 60 * (math.Mod((g-b)/delta, 6))
+		case g:
+			h = 60 * ((b-r)/delta + 2)
+		case b:
+			h = 60 * ((r-g)/delta + 4)
+		}
+
+		if h < 0 {
+			h += 360
+		}
+	}
+
+	return HSB64{H: h, S: s, B: brightness}
+}
+
+func (c RGB64) ToXYZ() XYZ {
+	x, y, z := srgbToXYZ.apply(srgbToLinear(c.R), srgbToLinear(c.G), srgbToLinear(c.B))
+	return XYZ{X: x, Y: y, Z: z}
+}
+
+// CMYK64 represents a color in CMYK color space with float64 precision.
+// Components are in the range 0.0-1.0.
+type CMYK64 struct {
+	C, M, Y, K float64
+}
+
+// NewCMYK64 creates a new high-precision CMYK color with validation.
+func NewCMYK64(c, m, y, k float64) CMYK64 {
+	return CMYK64{
+		C: clamp(c, 0, 1),
+		M: clamp(m, 0, 1),
+		Y: clamp(y, 0, 1),
+		K: clamp(k, 0, 1),
+	}
+}
+
+func (c CMYK64) String() string {
+	return fmt.Sprintf("CMYK(%.2f%%, %.2f%%, %.2f%%, %.2f%%)", c.C*100, c.M*100, c.Y*100, c.K*100)
+}
+
+func (c CMYK64) ColorSpace() string {
+	return "CMYK"
+}
+
+func (c CMYK64) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c CMYK64) ToCMYK() CMYK {
+	return CMYK{
+		C: uint8(math.Round(clamp(c.C, 0, 1) * 100)),
+		M: uint8(math.Round(clamp(c.M, 0, 1) * 100)),
+		Y: uint8(math.Round(clamp(c.Y, 0, 1) * 100)),
+		K: uint8(math.Round(clamp(c.K, 0, 1) * 100)),
+	}
+}
+
+func (c CMYK64) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c CMYK64) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c CMYK64) ToRGB64() RGB64 {
+	k := 1 - c.K
+	return NewRGB64((1-c.C)*k, (1-c.M)*k, (1-c.Y)*k)
+}
+
+func (c CMYK64) ToCMYK64() CMYK64 {
+	return c
+}
+
+func (c CMYK64) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c CMYK64) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c CMYK64) ToXYZ() XYZ {
+	return c.ToRGB64().ToXYZ()
+}
+
+// LAB64 represents a color in LAB color space with float64 precision.
+// L is in the range 0-100; A and B are unbounded but typically -128 to 127.
+//
+// White is the reference white the values are relative to. The zero value
+// means D65, which is what every other color's ToLAB64 produces.
+type LAB64 struct {
+	L, A, B float64
+	White   XYZ
+}
+
+// NewLAB64 creates a new high-precision D65 LAB color with validation.
+func NewLAB64(l, a, b float64) LAB64 {
+	return NewLAB64WithWhite(l, a, b, D65)
+}
+
+// NewLAB64WithWhite creates a new high-precision LAB color relative to the given reference white.
+func NewLAB64WithWhite(l, a, b float64, white XYZ) LAB64 {
+	c := LAB64{
+		L: clamp(l, 0, 100),
+		A: a,
+		B: b,
+	}
+	if white != D65 {
+		c.White = white
+	}
+	return c
+}
+
+// WhitePoint returns the reference white of the color.
+func (c LAB64) WhitePoint() XYZ {
+	if c.White == (XYZ{}) {
+		return D65
+	}
+	return c.White
+}
+
+// Adapt re-expresses the color relative to another reference white using
+// the given chromatic adaptation transform.
+func (c LAB64) Adapt(white XYZ, method Adaptation) LAB64 {
+	from := c.WhitePoint()
+	if from == white {
+		return c
+	}
+	return xyzToLAB(labToXYZ(c, from).Adapt(from, white, method), white)
+}
+
+func (c LAB64) String() string {
+	if white := c.WhitePoint(); white != D65 {
+		name := whitePointName(white)
+		if name == "" {
+			name = white.String()
+		}
+		return fmt.Sprintf("LAB(%.2f, %.2f, %.2f, %s)", c.L, c.A, c.B, name)
+	}
+	return fmt.Sprintf("LAB(%.2f, %.2f, %.2f)", c.L, c.A, c.B)
+}
+
+func (c LAB64) ColorSpace() string {
+	return "LAB"
+}
+
+func (c LAB64) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c LAB64) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c LAB64) ToLAB() LAB {
+	d65 := c.ToLAB64()
+	return LAB{
+		L: int8(math.Round(clamp(d65.L, 0, 100))),
+		A: int8(math.Round(clamp(d65.A, -128, 127))),
+		B: int8(math.Round(clamp(d65.B, -128, 127))),
+	}
+}
+
+func (c LAB64) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c LAB64) ToRGB64() RGB64 {
+	return c.ToXYZ().ToRGB64()
+}
+
+func (c LAB64) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+// ToLAB64 returns the color relative to D65, adapting it with Bradford if
+// it has a different reference white.
+func (c LAB64) ToLAB64() LAB64 {
+	return c.Adapt(D65, AdaptationBradford)
+}
+
+func (c LAB64) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+// ToXYZ converts the color to D65 XYZ, adapting it with Bradford if it has
+// a different reference white.
+func (c LAB64) ToXYZ() XYZ {
+	white := c.WhitePoint()
+	return labToXYZ(c, white).Adapt(white, D65, AdaptationBradford)
+}
+
+// RelativeXYZ converts the color to XYZ relative to its own reference white, without
+// adapting it to D65. It is the inverse of XYZ.ToLAB64WithWhite.
+func (c LAB64) RelativeXYZ() XYZ {
+	return labToXYZ(c, c.WhitePoint())
+}
+
+// HSB64 represents a color in HSB (HSV) color space with float64 precision.
+// H is in degrees (0-360); S and B are in the range 0.0-1.0.
+type HSB64 struct {
+	H, S, B float64
+}
+
+// NewHSB64 creates a new high-precision HSB color with validation.
+func NewHSB64(h, s, b float64) HSB64 {
+	return HSB64{
+		H: normalizeHue(h),
+		S: clamp(s, 0, 1),
+		B: clamp(b, 0, 1),
+	}
+}
+
+func (c HSB64) String() string {
+	return fmt.Sprintf("HSB(%.2f°, %.2f%%, %.2f%%)", c.H, c.S*100, c.B*100)
+}
+
+func (c HSB64) ColorSpace() string {
+	return "HSB"
+}
+
+func (c HSB64) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c HSB64) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c HSB64) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c HSB64) ToHSB() HSB {
+	return HSB{
+		H: uint16(math.Round(normalizeHue(c.H))) % 360,
+		S: uint8(math.Round(clamp(c.S, 0, 1) * 100)),
+		B: uint8(math.Round(clamp(c.B, 0, 1) * 100)),
+	}
+}
+
+func (c HSB64) ToRGB64() RGB64 {
+	h := normalizeHue(c.H)
+	s := clamp(c.S, 0, 1)
+	v := clamp(c.B, 0, 1)
+
+	chroma := v * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := v - chroma
+
+	var r, g, b float64
+
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	return NewRGB64(r+m, g+m, b+m)
+}
+
+func (c HSB64) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c HSB64) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c HSB64) ToHSB64() HSB64 {
+	return c
+}
+
+func (c HSB64) ToXYZ() XYZ {
+	return c.ToRGB64().ToXYZ()
+}
+
+// normalizeHue wraps a hue angle into the range [0, 360).
+func normalizeHue(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
@@ -0,0 +1,115 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRGB64(t *testing.T) {
+	tests := map[string]struct {
+		r, g, b float64
+		want    RGB
+	}{
+		"Red":        {1, 0, 0, RGB{255, 0, 0}},
+		"Mid gray":   {0.5, 0.5, 0.5, RGB{128, 128, 128}},
+		"Over range": {1.5, -0.5, 0, RGB{255, 0, 0}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewRGB64(tt.r, tt.g, tt.b)
+			if got := c.ColorSpace(); got != "RGB" {
+				t.Errorf("RGB64.ColorSpace() = %v, want RGB", got)
+			}
+			if got := c.ToRGB(); got != tt.want {
+				t.Errorf("RGB64.ToRGB() = %v, want %v", got, tt.want)
+			}
+			if got := c.ToRGB64(); got != c {
+				t.Errorf("RGB64.ToRGB64() = %v, want %v", got, c)
+			}
+		})
+	}
+}
+
+func TestPreciseString(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  string
+	}{
+		"RGB64":  {NewRGB64(1, 0.5, 0), "RGB(1.0000, 0.5000, 0.0000)"},
+		"CMYK64": {NewCMYK64(0.125, 0, 1, 0.5), "CMYK(12.50%, 0.00%, 100.00%, 50.00%)"},
+		"LAB64":  {NewLAB64(53.24, 80.09, 67.2), "LAB(53.24, 80.09, 67.20)"},
+		"HSB64":  {NewHSB64(370.5, 0.5, 1), "HSB(10.50°, 50.00%, 100.00%)"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.color.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreciseMatchesQuantized(t *testing.T) {
+	// The quantized conversions are defined in terms of the precise ones,
+	// so rounding the precise result must give the same answer.
+	colors := []Color{
+		NewRGB(128, 64, 192),
+		NewCMYK(20, 40, 60, 10),
+		NewLAB(50, 20, -30),
+		NewHSB(211, 86, 100),
+	}
+
+	for _, c := range colors {
+		t.Run(c.String(), func(t *testing.T) {
+			if got, want := c.ToRGB64().ToRGB(), c.ToRGB(); got != want {
+				t.Errorf("ToRGB64().ToRGB() = %v, want %v", got, want)
+			}
+			if got, want := c.ToCMYK64().ToCMYK(), c.ToCMYK(); got != want {
+				t.Errorf("ToCMYK64().ToCMYK() = %v, want %v", got, want)
+			}
+			if got, want := c.ToLAB64().ToLAB(), c.ToLAB(); got != want {
+				t.Errorf("ToLAB64().ToLAB() = %v, want %v", got, want)
+			}
+			if got, want := c.ToHSB64().ToHSB(), c.ToHSB(); got != want {
+				t.Errorf("ToHSB64().ToHSB() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPreciseRoundTrip(t *testing.T) {
+	// Chained conversions through float types should not drift
+	original := NewRGB64(0.2, 0.4, 0.6)
+
+	tests := map[string]func(RGB64) RGB64{
+		"CMYK": func(c RGB64) RGB64 { return c.ToCMYK64().ToRGB64() },
+		"LAB":  func(c RGB64) RGB64 { return c.ToLAB64().ToRGB64() },
+		"HSB":  func(c RGB64) RGB64 { return c.ToHSB64().ToRGB64() },
+	}
+
+	for name, convert := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := original
+			for range 10 {
+				c = convert(c)
+			}
+
+			const tolerance = 1e-9
+			if math.Abs(c.R-original.R) > tolerance ||
+				math.Abs(c.G-original.G) > tolerance ||
+				math.Abs(c.B-original.B) > tolerance {
+				t.Errorf("RGB64 -> %s round trips drifted: %v -> %v", name, original, c)
+			}
+		})
+	}
+}
+
+func TestHSB64ToHSBWrapsHue(t *testing.T) {
+	got := NewHSB64(359.7, 1, 1).ToHSB()
+	want := HSB{0, 100, 100}
+	if got != want {
+		t.Errorf("HSB64.ToHSB() = %v, want %v", got, want)
+	}
+}
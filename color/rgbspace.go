@@ -0,0 +1,241 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// Chromaticity is a CIE 1931 xy chromaticity coordinate.
+type Chromaticity struct {
+	X, Y float64
+}
+
+// RGBSpace describes an RGB working space: its primaries, reference white and
+// transfer function. Colors in the space are represented by SpaceRGB.
+type RGBSpace struct {
+	// Name identifies the space, e.g. "DisplayP3"
+	Name string
+	// Red, Green and Blue are the chromaticities of the primaries
+	Red, Green, Blue Chromaticity
+	// White is the reference white of the space
+	White XYZ
+
+	decode  func(float64) float64 // Encoded component to linear light
+	encode  func(float64) float64 // Linear light to encoded component
+	toXYZ   mat3                  // Linear RGB to XYZ relative to White
+	fromXYZ mat3                  // XYZ relative to White to linear RGB
+}
+
+// NewRGBSpace creates an RGB working space from its primaries, reference white
+// and transfer function. decode maps an encoded component to linear light and
+// encode is its inverse.
+func NewRGBSpace(name string, red, green, blue Chromaticity, white XYZ, decode, encode func(float64) float64) *RGBSpace {
+	s := &RGBSpace{
+		Name:   name,
+		Red:    red,
+		Green:  green,
+		Blue:   blue,
+		White:  white,
+		decode: decode,
+		encode: encode,
+	}
+
+	// Scale the primaries so that RGB(1, 1, 1) maps onto the white point
+	primaries := mat3{
+		{red.X / red.Y, green.X / green.Y, blue.X / blue.Y},
+		{1, 1, 1},
+		{(1 - red.X - red.Y) / red.Y, (1 - green.X - green.Y) / green.Y, (1 - blue.X - blue.Y) / blue.Y},
+	}
+	sr, sg, sb := primaries.inverse().apply(white.X, white.Y, white.Z)
+	s.toXYZ = primaries.mul(mat3{{sr, 0, 0}, {0, sg, 0}, {0, 0, sb}})
+	s.fromXYZ = s.toXYZ.inverse()
+
+	return s
+}
+
+// Standard RGB working spaces.
+var (
+	// SRGB is IEC 61966-2-1 sRGB, the space used by RGB and RGB64.
+	SRGB = NewRGBSpace("sRGB",
+		Chromaticity{0.64, 0.33}, Chromaticity{0.30, 0.60}, Chromaticity{0.15, 0.06},
+		D65, srgbToLinear, linearToSRGB)
+
+	// DisplayP3 is Apple's Display P3: DCI-P3 primaries with the sRGB white and transfer curve.
+	DisplayP3 = NewRGBSpace("DisplayP3",
+		Chromaticity{0.680, 0.320}, Chromaticity{0.265, 0.690}, Chromaticity{0.150, 0.060},
+		D65, srgbToLinear, linearToSRGB)
+
+	// AdobeRGB is Adobe RGB (1998).
+	AdobeRGB = NewRGBSpace("AdobeRGB",
+		Chromaticity{0.64, 0.33}, Chromaticity{0.21, 0.71}, Chromaticity{0.15, 0.06},
+		D65, gammaDecode(563.0/256), gammaEncode(563.0/256))
+
+	// ProPhoto is ProPhoto RGB (ROMM RGB), which is relative to D50.
+	ProPhoto = NewRGBSpace("ProPhoto",
+		Chromaticity{0.7347, 0.2653}, Chromaticity{0.1596, 0.8404}, Chromaticity{0.0366, 0.0001},
+		D50, prophotoToLinear, linearToProPhoto)
+
+	// Rec2020 is ITU-R BT.2020 with its camera transfer function.
+	Rec2020 = NewRGBSpace("Rec2020",
+		Chromaticity{0.708, 0.292}, Chromaticity{0.170, 0.797}, Chromaticity{0.131, 0.046},
+		D65, rec2020ToLinear, linearToRec2020)
+)
+
+// rgbSpaces maps normalized names and aliases to the standard working spaces.
+var rgbSpaces = map[string]*RGBSpace{
+	"srgb":         SRGB,
+	"displayp3":    DisplayP3,
+	"p3":           DisplayP3,
+	"adobergb":     AdobeRGB,
+	"adobergb1998": AdobeRGB,
+	"prophoto":     ProPhoto,
+	"prophotorgb":  ProPhoto,
+	"rommrgb":      ProPhoto,
+	"rec2020":      Rec2020,
+	"bt2020":       Rec2020,
+}
+
+// LookupRGBSpace returns the standard RGB working space with the given name or alias.
+// Matching ignores case, spaces, hyphens and underscores, so "Display P3" and
+// "display-p3" both find DisplayP3.
+func LookupRGBSpace(name string) (*RGBSpace, bool) {
+	space, ok := rgbSpaces[normalizeSpaceName(name)]
+	return space, ok
+}
+
+// RGBSpaces returns the standard wide-gamut RGB working spaces.
+func RGBSpaces() []*RGBSpace {
+	return []*RGBSpace{DisplayP3, AdobeRGB, ProPhoto, Rec2020}
+}
+
+// New creates a color in the space with validation. Components are in the range 0.0-1.0.
+func (s *RGBSpace) New(r, g, b float64) SpaceRGB {
+	return SpaceRGB{
+		R:     clamp(r, 0, 1),
+		G:     clamp(g, 0, 1),
+		B:     clamp(b, 0, 1),
+		Space: s,
+	}
+}
+
+// FromXYZ converts D65 XYZ values to the space, clamping to its gamut.
+func (s *RGBSpace) FromXYZ(c XYZ) SpaceRGB {
+	r, g, b := s.linear(c)
+	return s.New(s.encode(r), s.encode(g), s.encode(b))
+}
+
+// Convert converts any color to the space, clamping to its gamut.
+func (s *RGBSpace) Convert(c Color) SpaceRGB {
+	return s.FromXYZ(c.ToXYZ())
+}
+
+// linear converts D65 XYZ values to unclamped linear components in the space.
+func (s *RGBSpace) linear(c XYZ) (float64, float64, float64) {
+	c = c.Adapt(D65, s.White, AdaptationBradford)
+	return s.fromXYZ.apply(c.X, c.Y, c.Z)
+}
+
+// SpaceRGB represents a color in an RGB working space such as Display P3.
+// Components are encoded with the space's transfer function and are in the range 0.0-1.0.
+type SpaceRGB struct {
+	R, G, B float64
+	Space   *RGBSpace
+}
+
+func (c SpaceRGB) String() string {
+	return fmt.Sprintf("%s(%.4f, %.4f, %.4f)", c.Space.Name, c.R, c.G, c.B)
+}
+
+func (c SpaceRGB) ColorSpace() string {
+	return c.Space.Name
+}
+
+func (c SpaceRGB) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c SpaceRGB) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c SpaceRGB) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c SpaceRGB) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c SpaceRGB) ToRGB64() RGB64 {
+	return c.ToXYZ().ToRGB64()
+}
+
+func (c SpaceRGB) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c SpaceRGB) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c SpaceRGB) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c SpaceRGB) ToXYZ() XYZ {
+	s := c.Space
+	x, y, z := s.toXYZ.apply(s.decode(c.R), s.decode(c.G), s.decode(c.B))
+	return XYZ{X: x, Y: y, Z: z}.Adapt(s.White, D65, AdaptationBradford)
+}
+
+// gammaDecode returns a pure power-law decoding function.
+func gammaDecode(gamma float64) func(float64) float64 {
+	return func(v float64) float64 {
+		return math.Pow(math.Max(v, 0), gamma)
+	}
+}
+
+// gammaEncode returns a pure power-law encoding function.
+func gammaEncode(gamma float64) func(float64) float64 {
+	return func(v float64) float64 {
+		return math.Pow(math.Max(v, 0), 1/gamma)
+	}
+}
+
+// prophotoToLinear removes the ROMM RGB transfer curve from a component.
+func prophotoToLinear(v float64) float64 {
+	if v < 16.0/512 {
+		return v / 16
+	}
+	return math.Pow(v, 1.8)
+}
+
+// linearToProPhoto applies the ROMM RGB transfer curve to a linear component.
+func linearToProPhoto(v float64) float64 {
+	if v < 1.0/512 {
+		return 16 * v
+	}
+	return math.Pow(v, 1/1.8)
+}
+
+// BT.2020 transfer function constants.
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+// rec2020ToLinear removes the BT.2020 transfer curve from a component.
+func rec2020ToLinear(v float64) float64 {
+	if v < rec2020Beta*4.5 {
+		return v / 4.5
+	}
+	return math.Pow((v+rec2020Alpha-1)/rec2020Alpha, 1/0.45)
+}
+
+// linearToRec2020 applies the BT.2020 transfer curve to a linear component.
+func linearToRec2020(v float64) float64 {
+	if v < rec2020Beta {
+		return 4.5 * v
+	}
+	return rec2020Alpha*math.Pow(v, 0.45) - (rec2020Alpha - 1)
+}
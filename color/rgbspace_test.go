@@ -0,0 +1,94 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRGBSpaceFromSRGB(t *testing.T) {
+	// Reference values from the CSS Color 4 sample code for sRGB red
+	tests := map[string]struct {
+		space   *RGBSpace
+		r, g, b float64
+	}{
+		"DisplayP3": {DisplayP3, 0.9175, 0.2003, 0.1386},
+		"AdobeRGB":  {AdobeRGB, 0.8586, 0, 0},
+		"ProPhoto":  {ProPhoto, 0.7022, 0.2757, 0.1036},
+		"Rec2020":   {Rec2020, 0.7919, 0.2307, 0.0739},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.space.Convert(NewRGB(255, 0, 0))
+			if math.Abs(got.R-tt.r) > 0.002 || math.Abs(got.G-tt.g) > 0.002 || math.Abs(got.B-tt.b) > 0.002 {
+				t.Errorf("Convert() = %v, want (%.4f, %.4f, %.4f)", got, tt.r, tt.g, tt.b)
+			}
+			if back := got.ToRGB(); back != (RGB{255, 0, 0}) {
+				t.Errorf("ToRGB() = %v, want RGB(255, 0, 0)", back)
+			}
+		})
+	}
+}
+
+func TestRGBSpaceWhite(t *testing.T) {
+	for _, space := range append(RGBSpaces(), SRGB) {
+		t.Run(space.Name, func(t *testing.T) {
+			white := space.New(1, 1, 1).ToXYZ()
+			if math.Abs(white.X-D65.X) > 1e-4 || math.Abs(white.Y-D65.Y) > 1e-4 || math.Abs(white.Z-D65.Z) > 1e-4 {
+				t.Errorf("white ToXYZ() = %v, want %v", white, D65)
+			}
+			for _, v := range []float64{0, 0.001, 0.02, 0.2, 0.5, 1} {
+				if got := space.encode(space.decode(v)); math.Abs(got-v) > 1e-9 {
+					t.Errorf("encode(decode(%v)) = %v", v, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRGBSpaceRoundTrip(t *testing.T) {
+	// A saturated P3 green is outside sRGB but inside Rec.2020
+	p3 := DisplayP3.New(0, 1, 0)
+	rec := Rec2020.FromXYZ(p3.ToXYZ())
+	back := DisplayP3.FromXYZ(rec.ToXYZ())
+	if math.Abs(back.R-p3.R) > 1e-6 || math.Abs(back.G-p3.G) > 1e-6 || math.Abs(back.B-p3.B) > 1e-6 {
+		t.Errorf("round trip = %v, want %v", back, p3)
+	}
+	if srgb := SRGB.FromXYZ(p3.ToXYZ()); srgb.R != 0 || srgb.G != 1 {
+		t.Errorf("SRGB.FromXYZ() = %v, want clamped to the sRGB gamut", srgb)
+	}
+}
+
+func TestLookupRGBSpace(t *testing.T) {
+	tests := map[string]*RGBSpace{
+		"DisplayP3":        DisplayP3,
+		"display-p3":       DisplayP3,
+		"ADOBERGB":         AdobeRGB,
+		"Adobe RGB (1998)": AdobeRGB,
+		"prophoto-rgb":     ProPhoto,
+		"Rec.2020":         Rec2020,
+		"sRGB":             SRGB,
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got, ok := LookupRGBSpace(name); !ok || got != want {
+				t.Errorf("LookupRGBSpace(%q) = %v, %v, want %v", name, got, ok, want.Name)
+			}
+		})
+	}
+
+	if _, ok := LookupRGBSpace("CMYK"); ok {
+		t.Error("LookupRGBSpace(\"CMYK\") found a space")
+	}
+}
+
+func TestSpaceRGBString(t *testing.T) {
+	c := DisplayP3.New(1, 0.5, 0)
+	if got, want := c.String(), "DisplayP3(1.0000, 0.5000, 0.0000)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got := c.ColorSpace(); got != "DisplayP3" {
+		t.Errorf("ColorSpace() = %v, want DisplayP3", got)
+	}
+}
@@ -0,0 +1,128 @@
+package color
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+//go:generate go tool stringer -type=BlackGeneration -trimprefix=BlackGeneration
+type BlackGeneration int // How black ink replaces cyan, magenta and yellow in CMYK separation
+
+const (
+	BlackGenerationMaximum BlackGeneration = iota // Gray component replacement (GCR) of the whole gray component
+	BlackGenerationNone                           // No black ink; grays and black are printed with cyan, magenta and yellow
+	BlackGenerationLight                          // Light GCR, starting at 40% gray
+	BlackGenerationMedium                         // Medium GCR, starting at 20% gray
+	BlackGenerationHeavy                          // Heavy GCR, starting at 10% gray
+	BlackGenerationUCR                            // Under color removal: black only in neutral shadows
+)
+
+// ParseBlackGeneration parses a black generation name: "maximum", "none", "light",
+// "medium", "heavy" or "ucr".
+func ParseBlackGeneration(s string) (BlackGeneration, error) {
+	switch strings.ToLower(s) {
+	case "maximum", "max", "full", "gcr":
+		return BlackGenerationMaximum, nil
+	case "none":
+		return BlackGenerationNone, nil
+	case "light":
+		return BlackGenerationLight, nil
+	case "medium":
+		return BlackGenerationMedium, nil
+	case "heavy":
+		return BlackGenerationHeavy, nil
+	case "ucr":
+		return BlackGenerationUCR, nil
+	default:
+		return 0, fmt.Errorf("invalid black generation: %s (must be one of: maximum, none, light, medium, heavy, ucr)", s)
+	}
+}
+
+// Separation controls how colors are separated into CMYK inks. The zero value is the
+// separation ToCMYK64 uses: maximum black generation and no ink limit.
+type Separation struct {
+	BlackGeneration BlackGeneration // How black ink replaces cyan, magenta and yellow
+	InkLimit        float64         // Maximum total area coverage in percent, such as 300; 0 for no limit
+}
+
+// Separate returns the CMYK inks for c. Every black generation gives the same color in
+// the package's CMYK model; only the mix of inks differs.
+//
+// Colors above the ink limit first get more black in place of cyan, magenta and
+// yellow, which keeps their color. Dark colors that are still above the limit then
+// lose cyan, magenta and yellow evenly, and print lighter. Limits below 100% are
+// treated as 100%.
+func (s Separation) Separate(c Color) CMYK64 {
+	rgb := c.ToRGB64()
+	cyan := 1 - clamp(rgb.R, 0, 1)
+	magenta := 1 - clamp(rgb.G, 0, 1)
+	yellow := 1 - clamp(rgb.B, 0, 1)
+	gray := min(cyan, magenta, yellow)
+
+	var k float64
+	switch s.BlackGeneration {
+	case BlackGenerationNone:
+		k = 0
+	case BlackGenerationLight:
+		k = blackFrom(gray, 0.4)
+	case BlackGenerationMedium:
+		k = blackFrom(gray, 0.2)
+	case BlackGenerationHeavy:
+		k = blackFrom(gray, 0.1)
+	case BlackGenerationUCR:
+		neutral := 1 - (max(cyan, magenta, yellow) - gray)
+		k = neutral * blackFrom(gray, 0.5)
+	default:
+		k = gray
+	}
+
+	inks := withBlack(cyan, magenta, yellow, k)
+	if s.InkLimit <= 0 || inks.TotalInk() <= s.InkLimit {
+		return inks
+	}
+	limit := max(s.InkLimit, 100) / 100
+
+	// With u = 1 - K, the total coverage is 4 - u - (3 - C - M - Y) / u. Solve for the
+	// least extra black that brings it down to the limit.
+	a := 3 - cyan - magenta - yellow
+	if d := (4-limit)*(4-limit) - 4*a; d >= 0 {
+		if k := 1 - ((4-limit)-math.Sqrt(d))/2; k <= gray {
+			return withBlack(cyan, magenta, yellow, k)
+		}
+	}
+
+	// Replace the whole gray component, then scale back the colored inks
+	inks = withBlack(cyan, magenta, yellow, gray)
+	if cmy := inks.C + inks.M + inks.Y; cmy > 0 {
+		scale := clamp((limit-inks.K)/cmy, 0, 1)
+		inks.C *= scale
+		inks.M *= scale
+		inks.Y *= scale
+	}
+	return inks
+}
+
+// TotalInk returns the total area coverage of the inks in percent, from 0 to 400.
+func (c CMYK64) TotalInk() float64 {
+	return (c.C + c.M + c.Y + c.K) * 100
+}
+
+// withBlack separates cyan, magenta and yellow amounts into inks with k black.
+func withBlack(cyan, magenta, yellow, k float64) CMYK64 {
+	if k >= 1 {
+		return CMYK64{K: 1}
+	}
+	return CMYK64{
+		C: clamp((cyan-k)/(1-k), 0, 1),
+		M: clamp((magenta-k)/(1-k), 0, 1),
+		Y: clamp((yellow-k)/(1-k), 0, 1),
+		K: k,
+	}
+}
+
+// blackFrom returns the black for a gray component, rising linearly from none at
+// start to full black at 100% gray.
+func blackFrom(gray, start float64) float64 {
+	return max(0, (gray-start)/(1-start))
+}
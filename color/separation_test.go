@@ -0,0 +1,106 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSeparate(t *testing.T) {
+	gray := NewRGB64(0.5, 0.5, 0.5)
+	black := NewRGB64(0, 0, 0)
+
+	tests := map[string]struct {
+		separation Separation
+		color      Color
+		want       CMYK64
+	}{
+		"Maximum gray":       {Separation{}, gray, CMYK64{K: 0.5}},
+		"None gray":          {Separation{BlackGeneration: BlackGenerationNone}, gray, CMYK64{C: 0.5, M: 0.5, Y: 0.5}},
+		"Light gray":         {Separation{BlackGeneration: BlackGenerationLight}, gray, CMYK64{C: 0.4, M: 0.4, Y: 0.4, K: 1.0 / 6}},
+		"Medium gray":        {Separation{BlackGeneration: BlackGenerationMedium}, gray, CMYK64{C: 0.2, M: 0.2, Y: 0.2, K: 0.375}},
+		"Heavy gray":         {Separation{BlackGeneration: BlackGenerationHeavy}, gray, CMYK64{C: 0.1, M: 0.1, Y: 0.1, K: 4.0 / 9}},
+		"UCR midtone":        {Separation{BlackGeneration: BlackGenerationUCR}, gray, CMYK64{C: 0.5, M: 0.5, Y: 0.5}},
+		"UCR black":          {Separation{BlackGeneration: BlackGenerationUCR}, black, CMYK64{K: 1}},
+		"None black":         {Separation{BlackGeneration: BlackGenerationNone}, black, CMYK64{C: 1, M: 1, Y: 1}},
+		"UCR saturated":      {Separation{BlackGeneration: BlackGenerationUCR}, NewRGB64(0, 0, 0.2), CMYK64{C: 1, M: 1, Y: 0.32 / 0.52, K: 0.48}},
+		"Maximum saturated":  {Separation{}, NewRGB(255, 0, 0), CMYK64{M: 1, Y: 1}},
+		"Limit adds black":   {Separation{BlackGeneration: BlackGenerationNone, InkLimit: 250}, black, CMYK64{K: 1}},
+		"Limit under":        {Separation{BlackGeneration: BlackGenerationNone, InkLimit: 300}, black, CMYK64{C: 1, M: 1, Y: 1}},
+		"Limit scales inks":  {Separation{BlackGeneration: BlackGenerationNone, InkLimit: 150}, NewRGB64(0, 0, 0.2), CMYK64{C: 0.35, M: 0.35, K: 0.8}},
+		"Limit below 100":    {Separation{BlackGeneration: BlackGenerationNone, InkLimit: 50}, black, CMYK64{K: 1}},
+		"Matches ToCMYK64":   {Separation{}, NewRGB(200, 100, 50), NewRGB(200, 100, 50).ToCMYK64()},
+		"Other color spaces": {Separation{BlackGeneration: BlackGenerationNone}, NewCMYK64(0, 0, 0, 0.5), CMYK64{C: 0.5, M: 0.5, Y: 0.5}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.separation.Separate(tt.color)
+			if math.Abs(got.C-tt.want.C) > 1e-9 || math.Abs(got.M-tt.want.M) > 1e-9 ||
+				math.Abs(got.Y-tt.want.Y) > 1e-9 || math.Abs(got.K-tt.want.K) > 1e-9 {
+				t.Errorf("%+v.Separate(%v) = %v, want %v", tt.separation, tt.color, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeparateKeepsColor(t *testing.T) {
+	colors := []Color{
+		NewRGB(0, 0, 0),
+		NewRGB(26, 26, 26),
+		NewRGB(128, 128, 128),
+		NewRGB(40, 20, 60),
+		NewRGB(200, 100, 50),
+		NewRGB(0, 128, 255),
+		NewRGB(255, 255, 255),
+	}
+
+	for _, generation := range []BlackGeneration{
+		BlackGenerationMaximum, BlackGenerationNone, BlackGenerationLight,
+		BlackGenerationMedium, BlackGenerationHeavy, BlackGenerationUCR,
+	} {
+		t.Run(generation.String(), func(t *testing.T) {
+			for _, c := range colors {
+				want := c.ToRGB64()
+
+				got := Separation{BlackGeneration: generation}.Separate(c).ToRGB64()
+				if math.Abs(got.R-want.R) > 1e-9 || math.Abs(got.G-want.G) > 1e-9 || math.Abs(got.B-want.B) > 1e-9 {
+					t.Errorf("Separate(%v) prints as %v", c, got)
+				}
+
+				limited := Separation{BlackGeneration: generation, InkLimit: 240}.Separate(c)
+				if limited.TotalInk() > 240+1e-9 {
+					t.Errorf("Separate(%v) with 240%% limit = %v, total %.1f%%", c, limited, limited.TotalInk())
+				}
+			}
+		})
+	}
+}
+
+func TestParseBlackGeneration(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    BlackGeneration
+		wantErr bool
+	}{
+		"Maximum": {"maximum", BlackGenerationMaximum, false},
+		"GCR":     {"GCR", BlackGenerationMaximum, false},
+		"None":    {"none", BlackGenerationNone, false},
+		"Light":   {"Light", BlackGenerationLight, false},
+		"Medium":  {"medium", BlackGenerationMedium, false},
+		"Heavy":   {"HEAVY", BlackGenerationHeavy, false},
+		"UCR":     {"ucr", BlackGenerationUCR, false},
+		"Invalid": {"extra", 0, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseBlackGeneration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBlackGeneration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBlackGeneration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package color
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// Wavelength sampling of Spectral colors.
+const (
+	SpectralStart    = 380 // First wavelength of a Spectral, in nm
+	SpectralEnd      = 730 // Last wavelength of a Spectral, in nm
+	SpectralInterval = 10  // Wavelength interval of a Spectral, in nm
+)
+
+// SpectralSamples is the number of reflectance samples in a Spectral.
+const SpectralSamples = (SpectralEnd-SpectralStart)/SpectralInterval + 1
+
+//go:generate go tool stringer -type=Illuminant -trimprefix=Illuminant
+type Illuminant int // CIE standard illuminant for viewing spectral colors
+
+const (
+	IlluminantD65 Illuminant = iota // Noon daylight, 6504 K
+	IlluminantD50                   // Horizon daylight, 5003 K, the graphic arts viewing standard
+	IlluminantA                     // Incandescent tungsten light, 2856 K
+	IlluminantF2                    // Cool white fluorescent, 4230 K
+	IlluminantF11                   // Narrow-band white fluorescent, 4000 K
+)
+
+// ParseIlluminant parses a CIE standard illuminant name, such as "D65" or "F11".
+func ParseIlluminant(s string) (Illuminant, error) {
+	switch strings.ToLower(s) {
+	case "d65":
+		return IlluminantD65, nil
+	case "d50":
+		return IlluminantD50, nil
+	case "a":
+		return IlluminantA, nil
+	case "f2":
+		return IlluminantF2, nil
+	case "f11":
+		return IlluminantF11, nil
+	default:
+		return 0, fmt.Errorf("invalid illuminant: %s (must be one of: D65, D50, A, F2, F11)", s)
+	}
+}
+
+//go:generate go tool stringer -type=Observer -trimprefix=Observer
+type Observer int // CIE standard colorimetric observer
+
+const (
+	ObserverCIE1931 Observer = iota // CIE 1931 2° standard observer, for small fields of view
+	ObserverCIE1964                 // CIE 1964 10° supplementary standard observer, for large fields of view
+)
+
+// ParseObserver parses a CIE standard observer, given by its field of view ("2", "2°",
+// "10", "10°") or year ("cie1931", "cie1964").
+func ParseObserver(s string) (Observer, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "2", "2°", "2deg", "cie1931", "1931":
+		return ObserverCIE1931, nil
+	case "10", "10°", "10deg", "cie1964", "1964":
+		return ObserverCIE1964, nil
+	default:
+		return 0, fmt.Errorf("invalid observer: %s (must be one of: 2, 10)", s)
+	}
+}
+
+// Spectral represents a surface color by its spectral reflectance, as measured by a
+// spectrophotometer: the reflectance factor (0-1) from 380 nm to 730 nm in 10 nm steps.
+// Unlike tristimulus colors, a spectral color can be seen under any illuminant and
+// observer with XYZUnder and LABUnder.
+//
+// As a Color, it is seen under D65 by the CIE 1931 2° observer.
+type Spectral struct {
+	Reflectance [SpectralSamples]float64 // Reflectance factor at SpectralStart + i*SpectralInterval nm
+}
+
+// NewSpectral creates a new spectral color from reflectance factors (0-1) from 380 nm
+// to 730 nm in 10 nm steps.
+func NewSpectral(reflectance [SpectralSamples]float64) Spectral {
+	return Spectral{Reflectance: reflectance}
+}
+
+// NewSpectralSampled creates a spectral color from reflectance factors (0-1) measured
+// every interval nm from start nm, such as 400-700 nm in 10 nm steps or 360-780 nm in
+// 5 nm steps. The measurements are linearly interpolated to 10 nm steps; wavelengths
+// outside the measured range take the nearest measured value.
+func NewSpectralSampled(start, interval float64, reflectance []float64) (Spectral, error) {
+	if len(reflectance) == 0 {
+		return Spectral{}, fmt.Errorf("no reflectance values")
+	}
+	if len(reflectance) > 1 && !(interval > 0) {
+		return Spectral{}, fmt.Errorf("invalid wavelength interval: %g (must be positive)", interval)
+	}
+
+	var c Spectral
+	for i := range c.Reflectance {
+		c.Reflectance[i] = sampleAt(reflectance, start, interval, float64(SpectralStart+i*SpectralInterval))
+	}
+	return c, nil
+}
+
+// At returns the reflectance at a wavelength in nm, linearly interpolated between
+// samples. Wavelengths outside 380-730 nm take the nearest end value.
+func (c Spectral) At(wavelength float64) float64 {
+	return sampleAt(c.Reflectance[:], SpectralStart, SpectralInterval, wavelength)
+}
+
+// XYZUnder returns the tristimulus values of the color seen under an illuminant by an
+// observer, scaled so that a perfect white reflector has Y = 1. Reflectance beyond
+// 730 nm is taken as the 730 nm value.
+func (c Spectral) XYZUnder(illuminant Illuminant, observer Observer) XYZ {
+	weights := &spectralWeights()[clampIlluminant(illuminant)][clampObserver(observer)]
+
+	var xyz XYZ
+	for i, w := range weights.w {
+		r := c.At(float64(cieStart + i*cieStep))
+		xyz.X += r * w[0]
+		xyz.Y += r * w[1]
+		xyz.Z += r * w[2]
+	}
+	return xyz
+}
+
+// LABUnder returns the Lab values of the color seen under an illuminant by an observer,
+// relative to the illuminant's white point for that observer.
+func (c Spectral) LABUnder(illuminant Illuminant, observer Observer) LAB64 {
+	return xyzToLAB(c.XYZUnder(illuminant, observer), illuminant.WhitePoint(observer))
+}
+
+// WhitePoint returns the white point of the illuminant for an observer, normalized to
+// Y = 1. For D65 and D50 with the 2° observer, these are the D65 and D50 values used
+// throughout the package.
+func (i Illuminant) WhitePoint(observer Observer) XYZ {
+	return spectralWeights()[clampIlluminant(i)][clampObserver(observer)].white
+}
+
+// MetamerismIndex returns the CIE special metamerism index for a change of illuminant:
+// the color difference between two spectra under a test illuminant, after correcting
+// for their difference under the reference illuminant. Pairs that match under the
+// reference light but not under the test light, such as a print and a proof viewed
+// in a shop instead of a viewing booth, have a large index.
+//
+// The correction is additive in Lab, as in CIE 15, and the difference uses the
+// given formula with the reference spectrum a as the standard.
+func MetamerismIndex(a, b Spectral, reference, test Illuminant, observer Observer, method DeltaEMethod) float64 {
+	refA, refB := a.LABUnder(reference, observer), b.LABUnder(reference, observer)
+	testA, testB := a.LABUnder(test, observer), b.LABUnder(test, observer)
+
+	testB.L += refA.L - refB.L
+	testB.A += refA.A - refB.A
+	testB.B += refA.B - refB.B
+	return deltaE(testA, testB, method)
+}
+
+func (c Spectral) String() string {
+	values := make([]string, len(c.Reflectance))
+	for i, r := range c.Reflectance {
+		values[i] = fmt.Sprintf("%.4f", r)
+	}
+	return fmt.Sprintf("Spectral(%s)", strings.Join(values, ", "))
+}
+
+func (c Spectral) ColorSpace() string {
+	return "SPECTRAL"
+}
+
+func (c Spectral) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c Spectral) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c Spectral) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c Spectral) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c Spectral) ToRGB64() RGB64 {
+	return c.ToXYZ().ToRGB64()
+}
+
+func (c Spectral) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c Spectral) ToLAB64() LAB64 {
+	return c.ToXYZ().ToLAB64()
+}
+
+func (c Spectral) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c Spectral) ToXYZ() XYZ {
+	return c.XYZUnder(IlluminantD65, ObserverCIE1931)
+}
+
+// spectralWeightTable holds the tristimulus weights of an illuminant and observer on
+// the CIE table wavelengths, and the resulting white point.
+type spectralWeightTable struct {
+	w     [len(cie1931)][3]float64
+	white XYZ
+}
+
+// spectralWeights returns the weight tables of every illuminant and observer.
+var spectralWeights = sync.OnceValue(func() *[IlluminantF11 + 1][ObserverCIE1964 + 1]spectralWeightTable {
+	var tables [IlluminantF11 + 1][ObserverCIE1964 + 1]spectralWeightTable
+	for illuminant := range tables {
+		spd := illuminantSPD(Illuminant(illuminant))
+		for observer := range tables[illuminant] {
+			cmf := &cie1931
+			if Observer(observer) == ObserverCIE1964 {
+				cmf = &cie1964
+			}
+
+			t := &tables[illuminant][observer]
+			var k float64
+			for i := range t.w {
+				for j := range 3 {
+					t.w[i][j] = spd[i] * cmf[i][j]
+				}
+				k += t.w[i][1]
+			}
+
+			// Normalize so a perfect white has Y = 1, matching the package white points
+			white := XYZ{Y: 1}
+			for i := range t.w {
+				white.X += t.w[i][0] / k
+				white.Z += t.w[i][2] / k
+			}
+			target := white
+			if Observer(observer) == ObserverCIE1931 {
+				switch Illuminant(illuminant) {
+				case IlluminantD65:
+					target = D65
+				case IlluminantD50:
+					target = D50
+				}
+			}
+			for i := range t.w {
+				t.w[i][0] *= target.X / white.X / k
+				t.w[i][1] /= k
+				t.w[i][2] *= target.Z / white.Z / k
+			}
+			t.white = target
+		}
+	}
+	return &tables
+})
+
+// illuminantSPD returns the relative spectral power of an illuminant on the CIE table
+// wavelengths.
+func illuminantSPD(illuminant Illuminant) [len(cie1931)]float64 {
+	var spd [len(cie1931)]float64
+	switch illuminant {
+	case IlluminantA:
+		// CIE 15 definition, with the c2 value of its time
+		const c2, t = 1.435e7, 2848.0
+		for i := range spd {
+			wavelength := float64(cieStart + i*cieStep)
+			spd[i] = 100 * math.Pow(560/wavelength, 5) * (math.Exp(c2/(t*560)) - 1) / (math.Exp(c2/(t*wavelength)) - 1)
+		}
+	case IlluminantF2:
+		spd = illuminantF2
+	case IlluminantF11:
+		spd = illuminantF11
+	case IlluminantD50:
+		spd = daylightSPD(5003)
+	default:
+		spd = daylightSPD(6504)
+	}
+	return spd
+}
+
+// daylightSPD returns the relative spectral power of the CIE daylight illuminant at
+// correlated color temperature k, rounding M1 and M2 to three decimals as CIE 15 does.
+func daylightSPD(k float64) [len(cie1931)]float64 {
+	x, y := daylightChromaticity(k)
+	m := 0.0241 + 0.2562*x - 0.7341*y
+	m1 := math.Round((-1.3515-1.7703*x+5.9114*y)/m*1000) / 1000
+	m2 := math.Round((0.0300-31.4424*x+30.0717*y)/m*1000) / 1000
+
+	var spd [len(cie1931)]float64
+	for i, s := range daylightBasis {
+		spd[i] = s[0] + m1*s[1] + m2*s[2]
+	}
+	return spd
+}
+
+// sampleAt linearly interpolates samples taken every interval nm from start nm at a
+// wavelength, holding the end values outside the sampled range.
+func sampleAt(samples []float64, start, interval, wavelength float64) float64 {
+	if len(samples) == 1 {
+		return samples[0]
+	}
+
+	pos := (wavelength - start) / interval
+	if pos <= 0 {
+		return samples[0]
+	}
+	if pos >= float64(len(samples)-1) {
+		return samples[len(samples)-1]
+	}
+	i := int(pos)
+	return lerp(samples[i], samples[i+1], pos-float64(i))
+}
+
+func clampIlluminant(i Illuminant) Illuminant {
+	if i < IlluminantD65 || i > IlluminantF11 {
+		return IlluminantD65
+	}
+	return i
+}
+
+func clampObserver(o Observer) Observer {
+	if o != ObserverCIE1964 {
+		return ObserverCIE1931
+	}
+	return o
+}
@@ -0,0 +1,227 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+// flatSpectrum returns a spectral color with the same reflectance at every wavelength.
+func flatSpectrum(r float64) Spectral {
+	var c Spectral
+	for i := range c.Reflectance {
+		c.Reflectance[i] = r
+	}
+	return c
+}
+
+func TestIlluminantWhitePoint(t *testing.T) {
+	// ASTM E308 white points
+	tests := map[string]struct {
+		illuminant Illuminant
+		observer   Observer
+		want       XYZ
+	}{
+		"D65 2°":  {IlluminantD65, ObserverCIE1931, D65},
+		"D65 10°": {IlluminantD65, ObserverCIE1964, XYZ{X: 0.94811, Y: 1, Z: 1.07304}},
+		"D50 2°":  {IlluminantD50, ObserverCIE1931, D50},
+		"D50 10°": {IlluminantD50, ObserverCIE1964, XYZ{X: 0.96720, Y: 1, Z: 0.81427}},
+		"A 2°":    {IlluminantA, ObserverCIE1931, XYZ{X: 1.09850, Y: 1, Z: 0.35585}},
+		"A 10°":   {IlluminantA, ObserverCIE1964, XYZ{X: 1.11144, Y: 1, Z: 0.35200}},
+		"F2 2°":   {IlluminantF2, ObserverCIE1931, XYZ{X: 0.99187, Y: 1, Z: 0.67395}},
+		"F2 10°":  {IlluminantF2, ObserverCIE1964, XYZ{X: 1.03280, Y: 1, Z: 0.69026}},
+		"F11 2°":  {IlluminantF11, ObserverCIE1931, XYZ{X: 1.00966, Y: 1, Z: 0.64370}},
+		"F11 10°": {IlluminantF11, ObserverCIE1964, XYZ{X: 1.03866, Y: 1, Z: 0.65627}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.illuminant.WhitePoint(tt.observer)
+			if math.Abs(got.X-tt.want.X) > 0.001 || got.Y != 1 || math.Abs(got.Z-tt.want.Z) > 0.001 {
+				t.Errorf("WhitePoint() = %v, want %v", got, tt.want)
+			}
+
+			// A perfect white reflector is the white point
+			if white := flatSpectrum(1).XYZUnder(tt.illuminant, tt.observer); math.Abs(white.X-got.X) > 1e-9 || math.Abs(white.Y-1) > 1e-9 || math.Abs(white.Z-got.Z) > 1e-9 {
+				t.Errorf("XYZUnder() of white = %v, want %v", white, got)
+			}
+		})
+	}
+}
+
+func TestSpectral(t *testing.T) {
+	if got := flatSpectrum(1).ToRGB(); got != NewRGB(255, 255, 255) {
+		t.Errorf("white ToRGB() = %v, want RGB(255, 255, 255)", got)
+	}
+	if got := flatSpectrum(0).ToRGB(); got != NewRGB(0, 0, 0) {
+		t.Errorf("black ToRGB() = %v, want RGB(0, 0, 0)", got)
+	}
+
+	// A flat spectrum is neutral under every light
+	gray := flatSpectrum(0.18)
+	for illuminant := IlluminantD65; illuminant <= IlluminantF11; illuminant++ {
+		for _, observer := range []Observer{ObserverCIE1931, ObserverCIE1964} {
+			lab := gray.LABUnder(illuminant, observer)
+			if math.Abs(lab.L-49.5) > 0.1 || math.Abs(lab.A) > 1e-6 || math.Abs(lab.B) > 1e-6 {
+				t.Errorf("LABUnder(%s, %s) = %v, want neutral L 49.5", illuminant, observer, lab)
+			}
+		}
+	}
+
+	// A red surface: reflects long wavelengths only
+	var red Spectral
+	for i := range red.Reflectance {
+		if SpectralStart+i*SpectralInterval >= 600 {
+			red.Reflectance[i] = 0.9
+		} else {
+			red.Reflectance[i] = 0.05
+		}
+	}
+	if lab := red.ToLAB64(); lab.A < 40 || lab.B < 20 {
+		t.Errorf("red ToLAB64() = %v, want a strong red", lab)
+	}
+	if d65, a := red.LABUnder(IlluminantD65, ObserverCIE1931), red.LABUnder(IlluminantA, ObserverCIE1931); a.L <= d65.L {
+		t.Errorf("red is lighter under D65 (%v) than under A (%v)", d65, a)
+	}
+	if red.ColorSpace() != "SPECTRAL" {
+		t.Errorf("ColorSpace() = %q", red.ColorSpace())
+	}
+}
+
+func TestNewSpectralSampled(t *testing.T) {
+	// 400-700 nm in 20 nm steps, rising from 0.1 to 0.85
+	values := make([]float64, 16)
+	for i := range values {
+		values[i] = 0.1 + 0.05*float64(i)
+	}
+	c, err := NewSpectralSampled(400, 20, values)
+	if err != nil {
+		t.Fatalf("NewSpectralSampled() error = %v", err)
+	}
+
+	tests := map[float64]float64{
+		380: 0.1,   // Held below the measured range
+		400: 0.1,   // First sample
+		410: 0.125, // Interpolated
+		550: 0.475,
+		700: 0.85, // Last sample
+		730: 0.85, // Held above the measured range
+		800: 0.85, // Beyond the Spectral range
+	}
+	for wavelength, want := range tests {
+		if got := c.At(wavelength); math.Abs(got-want) > 1e-9 {
+			t.Errorf("At(%g) = %g, want %g", wavelength, got, want)
+		}
+	}
+
+	if _, err := NewSpectralSampled(400, 10, nil); err == nil {
+		t.Error("NewSpectralSampled() with no values should error")
+	}
+	if _, err := NewSpectralSampled(400, 0, values); err == nil {
+		t.Error("NewSpectralSampled() with a zero interval should error")
+	}
+	if c, err := NewSpectralSampled(400, 0, []float64{0.5}); err != nil || c != flatSpectrum(0.5) {
+		t.Errorf("NewSpectralSampled() with one value = %v, %v", c, err)
+	}
+}
+
+func TestMetamerismIndex(t *testing.T) {
+	// The tristimulus values under D65 are linear in the reflectance samples
+	var m [3][SpectralSamples]float64
+	for i := range SpectralSamples {
+		var unit Spectral
+		unit.Reflectance[i] = 1
+		xyz := unit.XYZUnder(IlluminantD65, ObserverCIE1931)
+		m[0][i], m[1][i], m[2][i] = xyz.X, xyz.Y, xyz.Z
+	}
+
+	// Remove the part of a wave that D65 viewers can see, leaving a metameric black
+	var black [SpectralSamples]float64
+	for i := range black {
+		black[i] = 0.1 * math.Sin(float64(i)/3)
+	}
+	var gram mat3
+	var proj [3]float64
+	for r := range 3 {
+		for c := range 3 {
+			for i := range SpectralSamples {
+				gram[r][c] += m[r][i] * m[c][i]
+			}
+		}
+		for i := range SpectralSamples {
+			proj[r] += m[r][i] * black[i]
+		}
+	}
+	k0, k1, k2 := gram.inverse().apply(proj[0], proj[1], proj[2])
+	for i := range black {
+		black[i] -= k0*m[0][i] + k1*m[1][i] + k2*m[2][i]
+	}
+
+	a := flatSpectrum(0.5)
+	b := a
+	for i := range b.Reflectance {
+		b.Reflectance[i] += black[i]
+	}
+
+	if d := DeltaE(a, b, DeltaECIEDE2000); d > 1e-6 {
+		t.Fatalf("metameric pair differs under D65 by %g", d)
+	}
+
+	tests := map[string]struct {
+		a, b     Spectral
+		test     Illuminant
+		method   DeltaEMethod
+		min, max float64
+	}{
+		"Same light":    {a, b, IlluminantD65, DeltaECIE76, 0, 1e-9},
+		"Identical":     {a, a, IlluminantF11, DeltaECIE76, 0, 1e-9},
+		"Incandescent":  {a, b, IlluminantA, DeltaECIE76, 0.5, 100},
+		"Fluorescent":   {a, b, IlluminantF11, DeltaECIEDE2000, 0.5, 100},
+		"Not metameric": {flatSpectrum(0.2), flatSpectrum(0.5), IlluminantA, DeltaECIE76, 0, 1e-6},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := MetamerismIndex(tt.a, tt.b, IlluminantD65, tt.test, ObserverCIE1931, tt.method)
+			if got < tt.min || got > tt.max {
+				t.Errorf("MetamerismIndex() = %g, want between %g and %g", got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestParseIlluminant(t *testing.T) {
+	tests := map[string]Illuminant{
+		"D65": IlluminantD65,
+		"d50": IlluminantD50,
+		"A":   IlluminantA,
+		"F2":  IlluminantF2,
+		"f11": IlluminantF11,
+	}
+	for s, want := range tests {
+		if got, err := ParseIlluminant(s); err != nil || got != want {
+			t.Errorf("ParseIlluminant(%q) = %v, %v; want %v", s, got, err, want)
+		}
+	}
+	if _, err := ParseIlluminant("F7"); err == nil {
+		t.Error("ParseIlluminant(F7) should error")
+	}
+}
+
+func TestParseObserver(t *testing.T) {
+	tests := map[string]Observer{
+		"2":       ObserverCIE1931,
+		"2°":      ObserverCIE1931,
+		"CIE1931": ObserverCIE1931,
+		"10":      ObserverCIE1964,
+		"10°":     ObserverCIE1964,
+		"cie1964": ObserverCIE1964,
+	}
+	for s, want := range tests {
+		if got, err := ParseObserver(s); err != nil || got != want {
+			t.Errorf("ParseObserver(%q) = %v, %v; want %v", s, got, err, want)
+		}
+	}
+	if _, err := ParseObserver("5"); err == nil {
+		t.Error("ParseObserver(5) should error")
+	}
+}
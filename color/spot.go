@@ -0,0 +1,44 @@
+package color
+
+import "fmt"
+
+// SpotColor is a premixed ink, such as a Pantone color, rather than a mix of process
+// inks. It embeds its alternate value, the process or Lab color used to display or
+// separate the ink, so every conversion behaves as for the alternate.
+type SpotColor struct {
+	Color
+	// Ink is the full ink name, e.g. "PANTONE 185 C".
+	Ink string
+	// Book is the color book the ink comes from, e.g. "PANTONE+ Solid Coated".
+	Book string
+}
+
+// NewSpot creates a spot color with the given ink name, book and alternate value.
+// Any alpha or spot ink already on the alternate is discarded.
+func NewSpot(ink, book string, alternate Color) SpotColor {
+	alternate = Opaque(alternate)
+	if spot, ok := alternate.(SpotColor); ok {
+		alternate = spot.Color
+	}
+	return SpotColor{
+		Color: alternate,
+		Ink:   ink,
+		Book:  book,
+	}
+}
+
+// AsSpot returns c as a spot color, looking through any alpha, and reports whether
+// it is one.
+func AsSpot(c Color) (SpotColor, bool) {
+	spot, ok := Opaque(c).(SpotColor)
+	return spot, ok
+}
+
+// WithAlternate returns the same ink with a new alternate value.
+func (c SpotColor) WithAlternate(alternate Color) SpotColor {
+	return NewSpot(c.Ink, c.Book, alternate)
+}
+
+func (c SpotColor) String() string {
+	return fmt.Sprintf("%s (%s)", c.Ink, c.Color.String())
+}
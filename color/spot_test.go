@@ -0,0 +1,71 @@
+package color
+
+import "testing"
+
+func TestNewSpot(t *testing.T) {
+	alternate := NewLAB64WithWhite(47.84, 72.16, 43.14, D50)
+
+	tests := map[string]struct {
+		alternate Color
+		want      Color
+	}{
+		"Lab":        {alternate, alternate},
+		"With alpha": {WithAlpha(alternate, 0.5), alternate},
+		"Spot":       {NewSpot("Other", "Book", alternate), alternate},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", tt.alternate)
+			if c.Color != tt.want {
+				t.Errorf("NewSpot() alternate = %v, want %v", c.Color, tt.want)
+			}
+			if got, want := c.ColorSpace(), tt.want.ColorSpace(); got != want {
+				t.Errorf("ColorSpace() = %v, want %v", got, want)
+			}
+			if got, want := c.ToCMYK(), tt.want.ToCMYK(); got != want {
+				t.Errorf("ToCMYK() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAsSpot(t *testing.T) {
+	spot := NewSpot("HKS 13 K", "HKS K", NewCMYK(0, 100, 100, 0))
+
+	tests := map[string]struct {
+		color Color
+		want  bool
+	}{
+		"Spot":       {spot, true},
+		"With alpha": {WithAlpha(spot, 0.5), true},
+		"Process":    {NewCMYK(0, 100, 100, 0), false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := AsSpot(tt.color)
+			if ok != tt.want {
+				t.Fatalf("AsSpot() ok = %v, want %v", ok, tt.want)
+			}
+			if ok && got != spot {
+				t.Errorf("AsSpot() = %v, want %v", got, spot)
+			}
+		})
+	}
+}
+
+func TestSpotWithAlternate(t *testing.T) {
+	spot := NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", NewRGB(228, 0, 43))
+	got := spot.WithAlternate(spot.ToCMYK64())
+
+	if got.Ink != spot.Ink || got.Book != spot.Book {
+		t.Errorf("WithAlternate() = %v, want ink and book kept", got)
+	}
+	if got.ColorSpace() != "CMYK" {
+		t.Errorf("WithAlternate() color space = %v, want CMYK", got.ColorSpace())
+	}
+	if want := "PANTONE 185 C (RGB(228, 0, 43))"; spot.String() != want {
+		t.Errorf("String() = %v, want %v", spot.String(), want)
+	}
+}
@@ -0,0 +1,220 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// XYZ represents a color in CIE 1931 XYZ color space.
+// Values are relative to the D65 white point, with Y = 1.0 for reference white.
+//
+// XYZ is the hub for all conversions between color spaces: each space only
+// needs a ToXYZ method and a matching conversion back from XYZ.
+type XYZ struct {
+	X, Y, Z float64
+}
+
+// NewXYZ creates a new XYZ color.
+func NewXYZ(x, y, z float64) XYZ {
+	return XYZ{X: x, Y: y, Z: z}
+}
+
+func (c XYZ) String() string {
+	return fmt.Sprintf("XYZ(%.4f, %.4f, %.4f)", c.X, c.Y, c.Z)
+}
+
+func (c XYZ) ColorSpace() string {
+	return "XYZ"
+}
+
+func (c XYZ) ToRGB() RGB {
+	return c.ToRGB64().ToRGB()
+}
+
+func (c XYZ) ToCMYK() CMYK {
+	return c.ToCMYK64().ToCMYK()
+}
+
+func (c XYZ) ToLAB() LAB {
+	return c.ToLAB64().ToLAB()
+}
+
+func (c XYZ) ToHSB() HSB {
+	return c.ToHSB64().ToHSB()
+}
+
+func (c XYZ) ToRGB64() RGB64 {
+	r, g, b := xyzToSRGB.apply(c.X, c.Y, c.Z)
+	return NewRGB64(linearToSRGB(r), linearToSRGB(g), linearToSRGB(b))
+}
+
+func (c XYZ) ToCMYK64() CMYK64 {
+	return c.ToRGB64().ToCMYK64()
+}
+
+func (c XYZ) ToLAB64() LAB64 {
+	return xyzToLAB(c, D65)
+}
+
+// ToLAB64WithWhite converts XYZ values that are relative to white, such as those of the
+// D50 ICC profile connection space, to LAB relative to the same white, without adaptation.
+func (c XYZ) ToLAB64WithWhite(white XYZ) LAB64 {
+	return xyzToLAB(c, white)
+}
+
+func (c XYZ) ToHSB64() HSB64 {
+	return c.ToRGB64().ToHSB64()
+}
+
+func (c XYZ) ToXYZ() XYZ {
+	return c
+}
+
+// mat3 is a 3x3 matrix used for linear color transforms.
+type mat3 [3][3]float64
+
+// apply multiplies the matrix by the column vector (a, b, c).
+func (m mat3) apply(a, b, c float64) (float64, float64, float64) {
+	return m[0][0]*a + m[0][1]*b + m[0][2]*c,
+		m[1][0]*a + m[1][1]*b + m[1][2]*c,
+		m[2][0]*a + m[2][1]*b + m[2][2]*c
+}
+
+// mul returns the matrix product m × n.
+func (m mat3) mul(n mat3) mat3 {
+	var out mat3
+	for i := range 3 {
+		for j := range 3 {
+			for k := range 3 {
+				out[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+	return out
+}
+
+// det returns the determinant of the matrix.
+func (m mat3) det() float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// inverse returns the inverse of the matrix.
+func (m mat3) inverse() mat3 {
+	det := m.det()
+
+	return mat3{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / det,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / det,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / det,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / det,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / det,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / det,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / det,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / det,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / det,
+		},
+	}
+}
+
+// Matrix3 is a 3x3 matrix for linear color transforms, such as the colorant matrix of
+// an ICC profile. It is applied to column vectors.
+type Matrix3 [3][3]float64
+
+// Apply multiplies the matrix by the column vector (a, b, c).
+func (m Matrix3) Apply(a, b, c float64) (float64, float64, float64) {
+	return mat3(m).apply(a, b, c)
+}
+
+// Inverse returns the inverse of the matrix, or false if the matrix is singular.
+func (m Matrix3) Inverse() (Matrix3, bool) {
+	if mat3(m).det() == 0 {
+		return Matrix3{}, false
+	}
+	return Matrix3(mat3(m).inverse()), true
+}
+
+var (
+	// srgbToXYZ converts linear sRGB to XYZ (D65).
+	srgbToXYZ = mat3{
+		{0.4124564, 0.3575761, 0.1804375},
+		{0.2126729, 0.7151522, 0.0721750},
+		{0.0193339, 0.1191920, 0.9503041},
+	}
+
+	// xyzToSRGB converts XYZ (D65) to linear sRGB.
+	xyzToSRGB = srgbToXYZ.inverse()
+)
+
+// CIE constants for the LAB companding function.
+const (
+	labEpsilon = 216.0 / 24389.0
+	labKappa   = 24389.0 / 27.0
+)
+
+// xyzToLAB converts XYZ values to LAB relative to the given white. The XYZ
+// values must already be adapted to that white.
+func xyzToLAB(c XYZ, white XYZ) LAB64 {
+	fx := labF(c.X / white.X)
+	fy := labF(c.Y / white.Y)
+	fz := labF(c.Z / white.Z)
+
+	lab := LAB64{
+		L: 116*fy - 16,
+		A: 500 * (fx - fy),
+		B: 200 * (fy - fz),
+	}
+	if white != D65 {
+		lab.White = white
+	}
+	return lab
+}
+
+// labToXYZ converts LAB values to XYZ relative to the given white, without adaptation.
+func labToXYZ(c LAB64, white XYZ) XYZ {
+	fy := (c.L + 16) / 116
+	fx := c.A/500 + fy
+	fz := fy - c.B/200
+
+	return XYZ{
+		X: labFInv(fx) * white.X,
+		Y: labFInv(fy) * white.Y,
+		Z: labFInv(fz) * white.Z,
+	}
+}
+
+func labF(t float64) float64 {
+	if t > labEpsilon {
+		return math.Cbrt(t)
+	}
+	return (labKappa*t + 16) / 116
+}
+
+func labFInv(t float64) float64 {
+	if t3 := t * t * t; t3 > labEpsilon {
+		return t3
+	}
+	return (116*t - 16) / labKappa
+}
+
+// srgbToLinear removes the sRGB transfer curve from a component.
+func srgbToLinear(v float64) float64 {
+	if v > 0.04045 {
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return v / 12.92
+}
+
+// linearToSRGB applies the sRGB transfer curve to a linear component.
+func linearToSRGB(v float64) float64 {
+	if v > 0.0031308 {
+		return 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return 12.92 * v
+}
@@ -0,0 +1,112 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestXYZ(t *testing.T) {
+	xyz := NewXYZ(0.4124, 0.2126, 0.0193)
+	if got := xyz.ColorSpace(); got != "XYZ" {
+		t.Errorf("XYZ.ColorSpace() = %v, want XYZ", got)
+	}
+	if got := xyz.String(); got != "XYZ(0.4124, 0.2126, 0.0193)" {
+		t.Errorf("XYZ.String() = %v, want XYZ(0.4124, 0.2126, 0.0193)", got)
+	}
+	if got := xyz.ToXYZ(); got != xyz {
+		t.Errorf("XYZ.ToXYZ() = %v, want %v", got, xyz)
+	}
+}
+
+func TestToXYZ(t *testing.T) {
+	tests := map[string]struct {
+		color Color
+		want  XYZ
+	}{
+		"White RGB":  {NewRGB(255, 255, 255), D65},
+		"Black RGB":  {NewRGB(0, 0, 0), XYZ{}},
+		"Red RGB":    {NewRGB(255, 0, 0), XYZ{0.4124564, 0.2126729, 0.0193339}},
+		"White LAB":  {NewLAB(100, 0, 0), D65},
+		"White CMYK": {NewCMYK(0, 0, 0, 0), D65},
+		"White HSB":  {NewHSB(0, 0, 100), D65},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.color.ToXYZ()
+			const tolerance = 1e-6
+			if math.Abs(got.X-tt.want.X) > tolerance ||
+				math.Abs(got.Y-tt.want.Y) > tolerance ||
+				math.Abs(got.Z-tt.want.Z) > tolerance {
+				t.Errorf("%T.ToXYZ() = %v, want %v", tt.color, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXYZRoundTrip(t *testing.T) {
+	colors := []Color{
+		NewRGB64(0.2, 0.4, 0.6),
+		NewLAB64(53.24, 80.09, 67.2),
+		NewCMYK64(0.1, 0.2, 0.3, 0.4),
+		NewHSB64(210, 0.5, 0.75),
+	}
+
+	for _, c := range colors {
+		t.Run(c.String(), func(t *testing.T) {
+			xyz := c.ToXYZ()
+
+			var back Color
+			switch c.(type) {
+			case RGB64:
+				back = xyz.ToRGB64()
+			case LAB64:
+				back = xyz.ToLAB64()
+			case CMYK64:
+				back = xyz.ToCMYK64()
+			case HSB64:
+				back = xyz.ToHSB64()
+			}
+
+			// Compare in XYZ since CMYK has several representations of the same color
+			got := back.ToXYZ()
+			const tolerance = 1e-9
+			if math.Abs(got.X-xyz.X) > tolerance ||
+				math.Abs(got.Y-xyz.Y) > tolerance ||
+				math.Abs(got.Z-xyz.Z) > tolerance {
+				t.Errorf("XYZ round trip of %v = %v, want %v", c, got, xyz)
+			}
+		})
+	}
+}
+
+func TestMat3Inverse(t *testing.T) {
+	identity := srgbToXYZ.mul(xyzToSRGB)
+	for i := range 3 {
+		for j := range 3 {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(identity[i][j]-want) > 1e-12 {
+				t.Errorf("srgbToXYZ × xyzToSRGB [%d][%d] = %v, want %v", i, j, identity[i][j], want)
+			}
+		}
+	}
+}
+
+func TestMatrix3Inverse(t *testing.T) {
+	m := Matrix3(srgbToXYZ)
+	inverse, ok := m.Inverse()
+	if !ok {
+		t.Fatal("Inverse() = false, want the sRGB matrix to be invertible")
+	}
+	r, g, b := inverse.Apply(m.Apply(0.2, 0.5, 0.8))
+	if math.Abs(r-0.2) > 1e-12 || math.Abs(g-0.5) > 1e-12 || math.Abs(b-0.8) > 1e-12 {
+		t.Errorf("Apply() round trip = (%v, %v, %v), want (0.2, 0.5, 0.8)", r, g, b)
+	}
+
+	if _, ok := (Matrix3{{1, 2, 3}, {2, 4, 6}, {0, 0, 1}}).Inverse(); ok {
+		t.Error("Inverse() of a singular matrix = true, want false")
+	}
+}
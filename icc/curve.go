@@ -0,0 +1,176 @@
+package icc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// curve is a one-dimensional transfer function on the range 0.0-1.0.
+type curve interface {
+	eval(x float64) float64
+}
+
+// gammaCurve is a pure power function. A gamma of 1 is the identity.
+type gammaCurve float64
+
+func (g gammaCurve) eval(x float64) float64 {
+	if g == 1 {
+		return clamp01(x)
+	}
+	return math.Pow(clamp01(x), float64(g))
+}
+
+// tableCurve is a sampled curve with equally spaced, linearly interpolated entries.
+type tableCurve []float64
+
+func (t tableCurve) eval(x float64) float64 {
+	pos := clamp01(x) * float64(len(t)-1)
+	i := int(pos)
+	if i >= len(t)-1 {
+		return t[len(t)-1]
+	}
+	frac := pos - float64(i)
+	return t[i] + (t[i+1]-t[i])*frac
+}
+
+// parametricCurve is one of the five parametricCurveType functions.
+type parametricCurve struct {
+	function            uint16
+	g, a, b, c, d, e, f float64
+}
+
+func (p parametricCurve) eval(x float64) float64 {
+	x = clamp01(x)
+	var y float64
+	switch p.function {
+	case 0:
+		y = math.Pow(x, p.g)
+	case 1:
+		if x >= -p.b/p.a {
+			y = math.Pow(math.Max(p.a*x+p.b, 0), p.g)
+		}
+	case 2:
+		y = p.c
+		if x >= -p.b/p.a {
+			y += math.Pow(math.Max(p.a*x+p.b, 0), p.g)
+		}
+	case 3:
+		if x >= p.d {
+			y = math.Pow(math.Max(p.a*x+p.b, 0), p.g)
+		} else {
+			y = p.c * x
+		}
+	case 4:
+		if x >= p.d {
+			y = math.Pow(math.Max(p.a*x+p.b, 0), p.g) + p.e
+		} else {
+			y = p.c*x + p.f
+		}
+	}
+	return clamp01(y)
+}
+
+// parametricParams is the number of parameters of each parametric function.
+var parametricParams = [...]int{1, 3, 4, 5, 7}
+
+// parseCurve reads a curveType or parametricCurveType element and returns it with
+// the number of bytes it occupies, excluding padding.
+func parseCurve(data []byte) (curve, int, error) {
+	if len(data) < 12 {
+		return nil, 0, fmt.Errorf("%w: short curve", ErrInvalidProfile)
+	}
+
+	switch tagType(data) {
+	case typeCurve:
+		n := int(binary.BigEndian.Uint32(data[8:]))
+		size := 12 + 2*n
+		if size > len(data) {
+			return nil, 0, fmt.Errorf("%w: curve with %d entries out of bounds", ErrInvalidProfile, n)
+		}
+		switch n {
+		case 0:
+			return gammaCurve(1), size, nil
+		case 1:
+			return gammaCurve(float64(binary.BigEndian.Uint16(data[12:])) / 256), size, nil
+		default:
+			t := make(tableCurve, n)
+			for i := range t {
+				t[i] = float64(binary.BigEndian.Uint16(data[12+2*i:])) / 65535
+			}
+			return t, size, nil
+		}
+
+	case typeParametricCurve:
+		function := binary.BigEndian.Uint16(data[8:])
+		if int(function) >= len(parametricParams) {
+			return nil, 0, fmt.Errorf("%w: unknown parametric function %d", ErrInvalidProfile, function)
+		}
+		size := 12 + 4*parametricParams[function]
+		if size > len(data) {
+			return nil, 0, fmt.Errorf("%w: parametric curve out of bounds", ErrInvalidProfile)
+		}
+		var params [7]float64
+		for i := range parametricParams[function] {
+			params[i] = s15Fixed16(int32(binary.BigEndian.Uint32(data[12+4*i:])))
+		}
+		p := parametricCurve{function: function, g: params[0], a: params[1], b: params[2], c: params[3], d: params[4], e: params[5], f: params[6]}
+		if function > 0 && p.a == 0 {
+			return nil, 0, fmt.Errorf("%w: parametric curve with zero slope", ErrInvalidProfile)
+		}
+		return p, size, nil
+
+	default:
+		return nil, 0, fmt.Errorf("%w: expected curve, got %q", ErrInvalidProfile, tagType(data))
+	}
+}
+
+// parseCurves reads n consecutive curves, each padded to a four byte boundary.
+func parseCurves(data []byte, n int) ([]curve, error) {
+	curves := make([]curve, n)
+	offset := 0
+	for i := range curves {
+		if offset >= len(data) {
+			return nil, fmt.Errorf("%w: curve %d out of bounds", ErrInvalidProfile, i)
+		}
+		c, size, err := parseCurve(data[offset:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read curve %d: %w", i, err)
+		}
+		curves[i] = c
+		offset += (size + 3) &^ 3
+	}
+	return curves, nil
+}
+
+// invertCurve finds x such that c.eval(x) = y. Curves in profiles are monotonic, so a
+// bisection search is enough for every curve type.
+func invertCurve(c curve, y float64) float64 {
+	if g, ok := c.(gammaCurve); ok {
+		return math.Pow(clamp01(y), 1/float64(g))
+	}
+
+	lo, hi := 0.0, 1.0
+	increasing := c.eval(1) >= c.eval(0)
+	for range 48 {
+		mid := (lo + hi) / 2
+		if (c.eval(mid) < y) == increasing {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+func applyCurves(curves []curve, values []float64) []float64 {
+	out := make([]float64, len(values))
+	for i, v := range values {
+		out[i] = curves[i].eval(v)
+	}
+	return out
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
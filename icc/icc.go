@@ -0,0 +1,310 @@
+// Package icc provides types for reading ICC color profiles and converting colors between them.
+//
+// Implements the parts of the ICC.1 (v2 and v4) [specification] needed for color conversion:
+// matrix/TRC and gray TRC profiles, and LUT-based profiles using the lut8 (mft1),
+// lut16 (mft2), lutAToB (mAB) and lutBToA (mBA) tag types.
+//
+// [specification]: https://www.color.org/specification/ICC.1-2022-05.pdf
+package icc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/kennyp/palette/color"
+)
+
+const (
+	FileSignature = "acsp" // Signature at offset 36 of every profile
+	HeaderSize    = 128    // Size of the profile header in bytes
+)
+
+var ErrInvalidProfile = errors.New("invalid ICC profile")
+
+// Signature is a four character code used by ICC profiles for classes, color spaces, tags and types.
+type Signature uint32
+
+// Profile classes.
+const (
+	ClassInput      Signature = 0x73636E72 // 'scnr'
+	ClassDisplay    Signature = 0x6D6E7472 // 'mntr'
+	ClassOutput     Signature = 0x70727472 // 'prtr'
+	ClassLink       Signature = 0x6C696E6B // 'link'
+	ClassColorSpace Signature = 0x73706163 // 'spac'
+	ClassAbstract   Signature = 0x61627374 // 'abst'
+	ClassNamedColor Signature = 0x6E6D636C // 'nmcl'
+)
+
+// Data and profile connection color spaces.
+const (
+	ColorSpaceXYZ  Signature = 0x58595A20 // 'XYZ '
+	ColorSpaceLab  Signature = 0x4C616220 // 'Lab '
+	ColorSpaceRGB  Signature = 0x52474220 // 'RGB '
+	ColorSpaceGray Signature = 0x47524159 // 'GRAY'
+	ColorSpaceCMYK Signature = 0x434D594B // 'CMYK'
+)
+
+// Tag signatures.
+const (
+	tagAToB0         Signature = 0x41324230 // 'A2B0'
+	tagBToA0         Signature = 0x42324130 // 'B2A0'
+	tagRedColorant   Signature = 0x7258595A // 'rXYZ'
+	tagGreenColorant Signature = 0x6758595A // 'gXYZ'
+	tagBlueColorant  Signature = 0x6258595A // 'bXYZ'
+	tagRedTRC        Signature = 0x72545243 // 'rTRC'
+	tagGreenTRC      Signature = 0x67545243 // 'gTRC'
+	tagBlueTRC       Signature = 0x62545243 // 'bTRC'
+	tagGrayTRC       Signature = 0x6B545243 // 'kTRC'
+	tagMediaWhite    Signature = 0x77747074 // 'wtpt'
+	tagDescription   Signature = 0x64657363 // 'desc'
+)
+
+// Tag type signatures.
+const (
+	typeCurve           Signature = 0x63757276 // 'curv'
+	typeParametricCurve Signature = 0x70617261 // 'para'
+	typeXYZ             Signature = 0x58595A20 // 'XYZ '
+	typeLut8            Signature = 0x6D667431 // 'mft1'
+	typeLut16           Signature = 0x6D667432 // 'mft2'
+	typeLutAToB         Signature = 0x6D414220 // 'mAB '
+	typeLutBToA         Signature = 0x6D424120 // 'mBA '
+	typeTextDescription Signature = 0x64657363 // 'desc'
+	typeMultiLocalized  Signature = 0x6D6C7563 // 'mluc'
+	typeText            Signature = 0x74657874 // 'text'
+)
+
+func (s Signature) String() string {
+	b := []byte{byte(s >> 24), byte(s >> 16), byte(s >> 8), byte(s)}
+	return strings.TrimRight(string(b), " ")
+}
+
+// Channels returns the number of components in a color space, or 0 if it is not supported.
+func (s Signature) Channels() int {
+	switch s {
+	case ColorSpaceGray:
+		return 1
+	case ColorSpaceXYZ, ColorSpaceLab, ColorSpaceRGB:
+		return 3
+	case ColorSpaceCMYK:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Profile is a parsed ICC profile.
+type Profile struct {
+	Version     uint32    // Raw version field, e.g. 0x04300000 for 4.3
+	Class       Signature // Device class, e.g. ClassOutput
+	ColorSpace  Signature // Data color space, e.g. ColorSpaceCMYK
+	PCS         Signature // Profile connection space, ColorSpaceXYZ or ColorSpaceLab
+	Intent      Intent    // Default rendering intent
+	Illuminant  color.XYZ // PCS illuminant, nominally D50
+	Description string    // Profile description, if present
+	tags        map[Signature][]byte
+}
+
+// Parse parses an ICC profile.
+func Parse(data []byte) (*Profile, error) {
+	p := &Profile{}
+	if err := p.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Read reads and parses an ICC profile.
+func Read(r io.Reader) (*Profile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile: %w", err)
+	}
+	return Parse(data)
+}
+
+// Open reads and parses the ICC profile at path.
+func Open(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open profile: %w", err)
+	}
+	p, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse profile %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// MajorVersion returns the major version of the profile, e.g. 2 or 4.
+func (p *Profile) MajorVersion() int {
+	return int(p.Version >> 24)
+}
+
+// HasTag reports whether the profile contains the tag.
+func (p *Profile) HasTag(sig Signature) bool {
+	_, ok := p.tags[sig]
+	return ok
+}
+
+func (p *Profile) UnmarshalBinary(data []byte) error {
+	if len(data) < HeaderSize+4 {
+		return fmt.Errorf("%w: %d bytes is too short", ErrInvalidProfile, len(data))
+	}
+
+	if string(data[36:40]) != FileSignature {
+		return fmt.Errorf("%w: missing %q signature", ErrInvalidProfile, FileSignature)
+	}
+
+	var header struct {
+		Size       uint32
+		CMM        uint32
+		Version    uint32
+		Class      Signature
+		ColorSpace Signature
+		PCS        Signature
+		Date       [12]byte
+		Signature  [4]byte
+		Platform   uint32
+		Flags      uint32
+		Maker      uint32
+		Model      uint32
+		Attributes uint64
+		Intent     uint32
+		Illuminant [3]int32
+	}
+	if err := binary.Read(bytes.NewReader(data), binary.BigEndian, &header); err != nil {
+		return fmt.Errorf("failed to read header: %w", err)
+	}
+
+	slog.Debug("parsed profile header",
+		slog.String("class", header.Class.String()),
+		slog.String("colorspace", header.ColorSpace.String()),
+		slog.String("pcs", header.PCS.String()),
+		slog.String("version", fmt.Sprintf("%08x", header.Version)))
+
+	if header.Size != 0 && header.Size < HeaderSize+4 {
+		return fmt.Errorf("%w: header size %d is too short", ErrInvalidProfile, header.Size)
+	}
+	if header.Size != 0 && int(header.Size) < len(data) {
+		data = data[:header.Size]
+	}
+
+	p.Version = header.Version
+	p.Class = header.Class
+	p.ColorSpace = header.ColorSpace
+	p.PCS = header.PCS
+	p.Intent = Intent(header.Intent & 0xFFFF)
+	p.Illuminant = color.XYZ{
+		X: s15Fixed16(header.Illuminant[0]),
+		Y: s15Fixed16(header.Illuminant[1]),
+		Z: s15Fixed16(header.Illuminant[2]),
+	}
+	if p.Illuminant.Y == 0 {
+		p.Illuminant = color.D50
+	}
+
+	if p.PCS != ColorSpaceXYZ && p.PCS != ColorSpaceLab {
+		return fmt.Errorf("%w: unsupported connection space %q", ErrInvalidProfile, p.PCS)
+	}
+
+	// Read tag table
+	count := binary.BigEndian.Uint32(data[HeaderSize:])
+	if uint64(count)*12 > uint64(len(data)-HeaderSize-4) {
+		return fmt.Errorf("%w: tag count %d exceeds profile size", ErrInvalidProfile, count)
+	}
+
+	p.tags = make(map[Signature][]byte, count)
+	for i := range int(count) {
+		entry := data[HeaderSize+4+i*12:]
+		sig := Signature(binary.BigEndian.Uint32(entry))
+		offset := binary.BigEndian.Uint32(entry[4:])
+		size := binary.BigEndian.Uint32(entry[8:])
+
+		if uint64(offset)+uint64(size) > uint64(len(data)) || size < 8 {
+			return fmt.Errorf("%w: tag %q at %d+%d is out of bounds", ErrInvalidProfile, sig, offset, size)
+		}
+
+		p.tags[sig] = data[offset : offset+size]
+
+		slog.Debug("parsed tag", slog.String("tag", sig.String()), slog.Int("offset", int(offset)), slog.Int("size", int(size)))
+	}
+
+	if desc, ok := p.tags[tagDescription]; ok {
+		text, err := parseText(desc)
+		if err != nil {
+			return fmt.Errorf("failed to read description: %w", err)
+		}
+		p.Description = text
+	}
+
+	return nil
+}
+
+// tagType returns the type signature of a tag's data.
+func tagType(data []byte) Signature {
+	return Signature(binary.BigEndian.Uint32(data))
+}
+
+// parseText reads a textDescriptionType, multiLocalizedUnicodeType or textType tag.
+// For localized text the first record is used.
+func parseText(data []byte) (string, error) {
+	switch tagType(data) {
+	case typeTextDescription:
+		if len(data) < 12 {
+			return "", fmt.Errorf("%w: short description", ErrInvalidProfile)
+		}
+		n := binary.BigEndian.Uint32(data[8:])
+		if uint64(n) > uint64(len(data)-12) {
+			return "", fmt.Errorf("%w: description length %d out of bounds", ErrInvalidProfile, n)
+		}
+		return strings.TrimRight(string(data[12:12+n]), "\x00"), nil
+
+	case typeMultiLocalized:
+		if len(data) < 16 {
+			return "", fmt.Errorf("%w: short localized text", ErrInvalidProfile)
+		}
+		if binary.BigEndian.Uint32(data[8:]) == 0 || len(data) < 28 {
+			return "", nil
+		}
+		length := binary.BigEndian.Uint32(data[20:])
+		offset := binary.BigEndian.Uint32(data[24:])
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			return "", fmt.Errorf("%w: localized text out of bounds", ErrInvalidProfile)
+		}
+		u16s := make([]uint16, length/2)
+		for i := range u16s {
+			u16s[i] = binary.BigEndian.Uint16(data[int(offset)+2*i:])
+		}
+		return strings.TrimRight(string(utf16.Decode(u16s)), "\x00"), nil
+
+	case typeText:
+		return strings.TrimRight(string(data[8:]), "\x00"), nil
+
+	default:
+		return "", fmt.Errorf("%w: unsupported text type %q", ErrInvalidProfile, tagType(data))
+	}
+}
+
+// parseXYZ reads an XYZType tag.
+func parseXYZ(data []byte) (color.XYZ, error) {
+	if tagType(data) != typeXYZ || len(data) < 20 {
+		return color.XYZ{}, fmt.Errorf("%w: expected XYZ tag, got %q", ErrInvalidProfile, tagType(data))
+	}
+	return color.XYZ{
+		X: s15Fixed16(int32(binary.BigEndian.Uint32(data[8:]))),
+		Y: s15Fixed16(int32(binary.BigEndian.Uint32(data[12:]))),
+		Z: s15Fixed16(int32(binary.BigEndian.Uint32(data[16:]))),
+	}, nil
+}
+
+// s15Fixed16 decodes a signed 15.16 fixed point number.
+func s15Fixed16(v int32) float64 {
+	return float64(v) / 65536
+}
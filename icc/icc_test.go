@@ -0,0 +1,583 @@
+package icc_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/kennyp/palette/color"
+	"github.com/kennyp/palette/icc"
+)
+
+// Test profiles are built in memory so each tag type can be exercised in isolation.
+
+type tag struct {
+	sig  string
+	data []byte
+}
+
+func sig(s string) uint32 {
+	return binary.BigEndian.Uint32([]byte(s))
+}
+
+func fixed(v float64) uint32 {
+	return uint32(int32(math.Round(v * 65536)))
+}
+
+func buildProfile(version uint32, class, space, pcs string, tags ...tag) []byte {
+	header := make([]byte, 128)
+	binary.BigEndian.PutUint32(header[8:], version)
+	binary.BigEndian.PutUint32(header[12:], sig(class))
+	binary.BigEndian.PutUint32(header[16:], sig(space))
+	binary.BigEndian.PutUint32(header[20:], sig(pcs))
+	copy(header[36:], "acsp")
+	binary.BigEndian.PutUint32(header[68:], fixed(0.9642))
+	binary.BigEndian.PutUint32(header[72:], fixed(1))
+	binary.BigEndian.PutUint32(header[76:], fixed(0.8249))
+
+	table := binary.BigEndian.AppendUint32(nil, uint32(len(tags)))
+	offset := 128 + 4 + 12*len(tags)
+	var body []byte
+	for _, t := range tags {
+		table = binary.BigEndian.AppendUint32(table, sig(t.sig))
+		table = binary.BigEndian.AppendUint32(table, uint32(offset+len(body)))
+		table = binary.BigEndian.AppendUint32(table, uint32(len(t.data)))
+		body = append(body, pad(t.data)...)
+	}
+
+	data := append(append(header, table...), body...)
+	binary.BigEndian.PutUint32(data, uint32(len(data)))
+	return data
+}
+
+func pad(data []byte) []byte {
+	for len(data)%4 != 0 {
+		data = append(data, 0)
+	}
+	return data
+}
+
+func typeHeader(typ string) []byte {
+	return binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, sig(typ)), 0)
+}
+
+func xyzTag(x, y, z float64) []byte {
+	data := typeHeader("XYZ ")
+	for _, v := range []float64{x, y, z} {
+		data = binary.BigEndian.AppendUint32(data, fixed(v))
+	}
+	return data
+}
+
+func curvTag(entries ...uint16) []byte {
+	data := binary.BigEndian.AppendUint32(typeHeader("curv"), uint32(len(entries)))
+	for _, e := range entries {
+		data = binary.BigEndian.AppendUint16(data, e)
+	}
+	return data
+}
+
+func paraTag(function uint16, params ...float64) []byte {
+	data := binary.BigEndian.AppendUint32(typeHeader("para"), uint32(function)<<16)
+	for _, p := range params {
+		data = binary.BigEndian.AppendUint32(data, fixed(p))
+	}
+	return data
+}
+
+func descTag(s string) []byte {
+	data := binary.BigEndian.AppendUint32(typeHeader("desc"), uint32(len(s)+1))
+	data = append(append(data, s...), 0)
+	return append(data, make([]byte, 78)...) // Unicode and ScriptCode records
+}
+
+func mlucTag(s string) []byte {
+	text := utf16.Encode([]rune(s))
+	data := typeHeader("mluc")
+	data = binary.BigEndian.AppendUint32(data, 1)
+	data = binary.BigEndian.AppendUint32(data, 12)
+	data = append(data, "enUS"...)
+	data = binary.BigEndian.AppendUint32(data, uint32(2*len(text)))
+	data = binary.BigEndian.AppendUint32(data, 28)
+	for _, u := range text {
+		data = binary.BigEndian.AppendUint16(data, u)
+	}
+	return data
+}
+
+// gridFunc returns the normalized outputs for a normalized CLUT grid point.
+type gridFunc func(in []float64) []float64
+
+// sampleGrid evaluates f at every point of a grid with 2 points per dimension.
+func sampleGrid(in int, f gridFunc) []float64 {
+	var samples []float64
+	for i := range 1 << in {
+		point := make([]float64, in)
+		for d := range in {
+			// First dimension varies slowest
+			if i&(1<<(in-1-d)) != 0 {
+				point[d] = 1
+			}
+		}
+		samples = append(samples, f(point)...)
+	}
+	return samples
+}
+
+func lutNTag(typ string, in, out int, f gridFunc) []byte {
+	entries, precision := 256, 1
+	if typ == "mft2" {
+		entries, precision = 2, 2
+	}
+
+	data := append(typeHeader(typ), byte(in), byte(out), 2, 0)
+	for i := range 9 {
+		v := 0.0
+		if i%4 == 0 {
+			v = 1
+		}
+		data = binary.BigEndian.AppendUint32(data, fixed(v))
+	}
+	if typ == "mft2" {
+		data = binary.BigEndian.AppendUint16(data, uint16(entries))
+		data = binary.BigEndian.AppendUint16(data, uint16(entries))
+	}
+
+	put := func(v float64) {
+		if precision == 1 {
+			data = append(data, byte(math.Round(v*255)))
+		} else {
+			data = binary.BigEndian.AppendUint16(data, uint16(math.Round(v*65535)))
+		}
+	}
+	identity := func(n int) {
+		for range n {
+			for j := range entries {
+				put(float64(j) / float64(entries-1))
+			}
+		}
+	}
+
+	identity(in)
+	for _, v := range sampleGrid(in, f) {
+		put(v)
+	}
+	identity(out)
+	return data
+}
+
+func lutABTag(typ string, in, out int, f gridFunc) []byte {
+	curves := func(n int) []byte {
+		var data []byte
+		for range n {
+			data = append(data, curvTag()...)
+		}
+		return data
+	}
+
+	pcs, device := out, in
+	if typ == "mBA " {
+		pcs, device = in, out
+	}
+
+	b := curves(pcs)
+	a := curves(device)
+	clut := make([]byte, 20)
+	for i := range in {
+		clut[i] = 2
+	}
+	clut[16] = 2
+	for _, v := range sampleGrid(in, f) {
+		clut = binary.BigEndian.AppendUint16(clut, uint16(math.Round(v*65535)))
+	}
+	clut = pad(clut)
+
+	offsetB := 32
+	offsetCLUT := offsetB + len(b)
+	offsetA := offsetCLUT + len(clut)
+
+	data := append(typeHeader(typ), byte(in), byte(out), 0, 0)
+	for _, offset := range []int{offsetB, 0, 0, offsetCLUT, offsetA} {
+		data = binary.BigEndian.AppendUint32(data, uint32(offset))
+	}
+	return append(append(append(data, b...), clut...), a...)
+}
+
+// labEncoding converts Lab to normalized values for a LUT type.
+func labEncoding(typ string, l, a, b float64) []float64 {
+	values := []float64{l / 100, (a + 128) / 255, (b + 128) / 255}
+	if typ == "mft2" {
+		for i := range values {
+			values[i] *= 65280.0 / 65535
+		}
+	}
+	return values
+}
+
+// cmykProfile builds a Lab-based CMYK profile where lightness depends only on K.
+func cmykProfile(typ string) []byte {
+	toLab := func(in []float64) []float64 { return labEncoding(typ, 100*(1-in[3]), 0, 0) }
+	fromLab := func(in []float64) []float64 {
+		l := in[0]
+		if typ == "mft2" {
+			l *= 65535.0 / 65280
+		}
+		return []float64{0, 0, 0, math.Max(0, 1-l)}
+	}
+
+	aToB, bToA := typ, typ
+	if typ == "mAB " {
+		bToA = "mBA "
+	}
+
+	build := func(t string, in, out int, f gridFunc) []byte {
+		if t == "mft1" || t == "mft2" {
+			return lutNTag(t, in, out, f)
+		}
+		return lutABTag(t, in, out, f)
+	}
+
+	return buildProfile(0x04300000, "prtr", "CMYK", "Lab ",
+		tag{"desc", mlucTag("Test CMYK")},
+		tag{"A2B0", build(aToB, 4, 3, toLab)},
+		tag{"B2A0", build(bToA, 3, 4, fromLab)},
+	)
+}
+
+// srgbProfile builds a matrix/TRC profile equivalent to sRGB.
+func srgbProfile() []byte {
+	trc := paraTag(3, 2.4, 1/1.055, 0.055/1.055, 1/12.92, 0.04045)
+	return buildProfile(0x02100000, "mntr", "RGB ", "XYZ ",
+		tag{"desc", descTag("sRGB test")},
+		tag{"wtpt", xyzTag(0.9642, 1, 0.8249)},
+		tag{"rXYZ", xyzTag(0.4360747, 0.2225045, 0.0139322)},
+		tag{"gXYZ", xyzTag(0.3850649, 0.7168786, 0.0971045)},
+		tag{"bXYZ", xyzTag(0.1430804, 0.0606169, 0.7141733)},
+		tag{"rTRC", trc},
+		tag{"gTRC", trc},
+		tag{"bTRC", trc},
+	)
+}
+
+func grayProfile(white float64) []byte {
+	return buildProfile(0x02100000, "mntr", "GRAY", "XYZ ",
+		tag{"wtpt", xyzTag(0.9642*white, white, 0.8249*white)},
+		tag{"kTRC", curvTag()},
+	)
+}
+
+func mustParse(t *testing.T, data []byte) *icc.Profile {
+	t.Helper()
+	p, err := icc.Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return p
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		data        []byte
+		version     int
+		class       icc.Signature
+		colorSpace  icc.Signature
+		pcs         icc.Signature
+		description string
+	}{
+		"v2 matrix/TRC": {srgbProfile(), 2, icc.ClassDisplay, icc.ColorSpaceRGB, icc.ColorSpaceXYZ, "sRGB test"},
+		"v4 LUT":        {cmykProfile("mAB "), 4, icc.ClassOutput, icc.ColorSpaceCMYK, icc.ColorSpaceLab, "Test CMYK"},
+		"Gray":          {grayProfile(1), 2, icc.ClassDisplay, icc.ColorSpaceGray, icc.ColorSpaceXYZ, ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := mustParse(t, tt.data)
+			if got := p.MajorVersion(); got != tt.version {
+				t.Errorf("MajorVersion() = %d, want %d", got, tt.version)
+			}
+			if p.Class != tt.class || p.ColorSpace != tt.colorSpace || p.PCS != tt.pcs {
+				t.Errorf("header = %s %s %s, want %s %s %s", p.Class, p.ColorSpace, p.PCS, tt.class, tt.colorSpace, tt.pcs)
+			}
+			if p.Description != tt.description {
+				t.Errorf("Description = %q, want %q", p.Description, tt.description)
+			}
+			if math.Abs(p.Illuminant.X-0.9642) > 1e-4 {
+				t.Errorf("Illuminant = %v, want D50", p.Illuminant)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	valid := srgbProfile()
+
+	badSignature := append([]byte(nil), valid...)
+	copy(badSignature[36:], "xxxx")
+
+	badTag := append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(badTag[128+4+4:], uint32(len(valid))) // First tag offset
+
+	smallSize := append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(smallSize, 100) // Header size
+
+	truncatedTags := append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(truncatedTags, 128+4+4) // Header size cutting the tag table short
+
+	tests := map[string][]byte{
+		"Empty":            nil,
+		"Header only":      valid[:128],
+		"Bad signature":    badSignature,
+		"Tag out of range": badTag,
+		"Size too small":   smallSize,
+		"Size cuts tags":   truncatedTags,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := icc.Parse(data); !errors.Is(err, icc.ErrInvalidProfile) {
+				t.Errorf("Parse() error = %v, want ErrInvalidProfile", err)
+			}
+		})
+	}
+}
+
+func TestMatrixTRC(t *testing.T) {
+	srgb := mustParse(t, srgbProfile())
+
+	// Colorimetric sRGB values into the profile come back unchanged
+	into, err := icc.NewTransform(nil, srgb, icc.IntentRelativeColorimetric)
+	if err != nil {
+		t.Fatalf("NewTransform() error = %v", err)
+	}
+	for _, c := range []color.RGB{color.NewRGB(255, 0, 0), color.NewRGB(255, 128, 0), color.NewRGB(12, 200, 90), color.NewRGB(255, 255, 255)} {
+		got, err := into.Convert(c)
+		if err != nil {
+			t.Fatalf("Convert() error = %v", err)
+		}
+		if rgb := got.ToRGB(); rgb != c {
+			t.Errorf("Convert(%v) = %v, want %v", c, rgb, c)
+		}
+	}
+
+	// Device values out of the profile match the library's own Lab
+	out, err := icc.NewTransform(srgb, nil, icc.IntentPerceptual)
+	if err != nil {
+		t.Fatalf("NewTransform() error = %v", err)
+	}
+	c := color.NewRGB(200, 30, 60)
+	got, err := out.Convert(c)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	want := c.ToLAB64()
+	lab := got.ToLAB64()
+	if math.Abs(lab.L-want.L) > 0.1 || math.Abs(lab.A-want.A) > 0.2 || math.Abs(lab.B-want.B) > 0.2 {
+		t.Errorf("Convert() = %v, want %v", lab, want)
+	}
+}
+
+func TestLUTProfiles(t *testing.T) {
+	for _, typ := range []string{"mft1", "mft2", "mAB "} {
+		t.Run(typ, func(t *testing.T) {
+			cmyk := mustParse(t, cmykProfile(typ))
+
+			roundTrip, err := icc.NewTransform(cmyk, cmyk, icc.IntentPerceptual)
+			if err != nil {
+				t.Fatalf("NewTransform() error = %v", err)
+			}
+			values, err := roundTrip.Apply([]float64{0, 0, 0, 0.5})
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if math.Abs(values[3]-0.5) > 0.01 {
+				t.Errorf("Apply() = %v, want K 0.5", values)
+			}
+
+			toLab, err := icc.NewTransform(cmyk, nil, icc.IntentRelativeColorimetric)
+			if err != nil {
+				t.Fatalf("NewTransform() error = %v", err)
+			}
+			got, err := toLab.Convert(color.NewCMYK64(0, 0, 0, 0.25))
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if lab := got.ToLAB64(); math.Abs(lab.L-75) > 0.5 || math.Abs(lab.A) > 0.5 || math.Abs(lab.B) > 0.5 {
+				t.Errorf("Convert() = %v, want Lab(75, 0, 0)", lab)
+			}
+
+			toCMYK, err := icc.NewTransform(nil, cmyk, icc.IntentRelativeColorimetric)
+			if err != nil {
+				t.Fatalf("NewTransform() error = %v", err)
+			}
+			got, err = toCMYK.Convert(color.NewLAB64(40, 0, 0))
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if k := got.ToCMYK64().K; math.Abs(k-0.6) > 0.01 {
+				t.Errorf("Convert() = %v, want K 0.6", got)
+			}
+			if got.ColorSpace() != "CMYK" {
+				t.Errorf("Convert() color space = %s, want CMYK", got.ColorSpace())
+			}
+		})
+	}
+}
+
+func TestIntentSelectsTable(t *testing.T) {
+	constant := func(k float64) gridFunc {
+		return func([]float64) []float64 { return []float64{0, 0, 0, k} }
+	}
+	data := buildProfile(0x04300000, "prtr", "CMYK", "Lab ",
+		tag{"B2A0", lutABTag("mBA ", 3, 4, constant(0.2))},
+		tag{"B2A1", lutABTag("mBA ", 3, 4, constant(0.4))},
+	)
+	p := mustParse(t, data)
+
+	tests := map[icc.Intent]float64{
+		icc.IntentPerceptual:           0.2,
+		icc.IntentRelativeColorimetric: 0.4,
+		icc.IntentSaturation:           0.2, // No B2A2, falls back to B2A0
+		icc.IntentAbsoluteColorimetric: 0.4,
+	}
+
+	for intent, want := range tests {
+		t.Run(intent.String(), func(t *testing.T) {
+			tr, err := icc.NewTransform(nil, p, intent)
+			if err != nil {
+				t.Fatalf("NewTransform() error = %v", err)
+			}
+			got, err := tr.Convert(color.NewRGB(128, 128, 128))
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if k := got.ToCMYK64().K; math.Abs(k-want) > 0.001 {
+				t.Errorf("K = %v, want %v", k, want)
+			}
+		})
+	}
+}
+
+func TestAbsoluteColorimetric(t *testing.T) {
+	src := mustParse(t, grayProfile(1))
+	dst := mustParse(t, grayProfile(0.5))
+
+	tests := map[icc.Intent]float64{
+		icc.IntentRelativeColorimetric: 0.25,
+		icc.IntentAbsoluteColorimetric: 0.5,
+	}
+
+	for intent, want := range tests {
+		t.Run(intent.String(), func(t *testing.T) {
+			tr, err := icc.NewTransform(src, dst, intent)
+			if err != nil {
+				t.Fatalf("NewTransform() error = %v", err)
+			}
+			got, err := tr.Apply([]float64{0.25})
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if math.Abs(got[0]-want) > 1e-6 {
+				t.Errorf("Apply() = %v, want %v", got[0], want)
+			}
+		})
+	}
+}
+
+func TestConvertKeepsAlpha(t *testing.T) {
+	tr, err := icc.NewTransform(nil, mustParse(t, cmykProfile("mft2")), icc.IntentPerceptual)
+	if err != nil {
+		t.Fatalf("NewTransform() error = %v", err)
+	}
+	got, err := tr.Convert(color.WithAlpha(color.NewRGB(0, 0, 0), 0.5))
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if a := color.AlphaOf(got); a != 0.5 {
+		t.Errorf("AlphaOf() = %v, want 0.5", a)
+	}
+}
+
+func TestNewTransformErrors(t *testing.T) {
+	if _, err := icc.NewTransform(nil, nil, icc.IntentPerceptual); err == nil {
+		t.Error("NewTransform(nil, nil) error = nil")
+	}
+
+	incomplete := mustParse(t, buildProfile(0x02100000, "mntr", "RGB ", "XYZ ", tag{"rTRC", curvTag()}))
+	if _, err := icc.NewTransform(incomplete, nil, icc.IntentPerceptual); !errors.Is(err, icc.ErrInvalidProfile) {
+		t.Errorf("NewTransform() error = %v, want ErrInvalidProfile", err)
+	}
+}
+
+func TestNewTransformInvalidLUT(t *testing.T) {
+	identity := func(in []float64) []float64 { return in[:1] }
+
+	// lut8 tables with 255 grid points per input and too little data for them. With 15
+	// inputs and outputs, the CLUT size overflows an int.
+	oversized := func(in, out byte) []byte {
+		data := append(typeHeader("mft1"), in, out, 255, 0)
+		return append(data, make([]byte, 36+int(in)*256+64)...)
+	}
+
+	tests := map[string]struct {
+		profile []byte
+		src     bool
+	}{
+		"RGB AToB with 4 inputs and 1 output": {
+			buildProfile(0x02100000, "mntr", "RGB ", "Lab ", tag{"A2B0", lutNTag("mft2", 4, 1, identity)}),
+			true,
+		},
+		"CMYK BToA with 3 inputs and 1 output": {
+			buildProfile(0x02100000, "prtr", "CMYK", "Lab ",
+				tag{"A2B0", lutNTag("mft2", 4, 3, func(in []float64) []float64 { return in[:3] })},
+				tag{"B2A0", lutNTag("mft2", 3, 1, identity)},
+			),
+			false,
+		},
+		"Oversized CLUT": {
+			buildProfile(0x02100000, "mntr", "RGB ", "Lab ", tag{"A2B0", oversized(3, 3)}),
+			true,
+		},
+		"Overflowing CLUT": {
+			buildProfile(0x02100000, "mntr", "RGB ", "Lab ", tag{"A2B0", oversized(15, 15)}),
+			true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := mustParse(t, tt.profile)
+			src, dst := p, (*icc.Profile)(nil)
+			if !tt.src {
+				src, dst = nil, p
+			}
+			if _, err := icc.NewTransform(src, dst, icc.IntentPerceptual); !errors.Is(err, icc.ErrInvalidProfile) {
+				t.Errorf("NewTransform() error = %v, want ErrInvalidProfile", err)
+			}
+		})
+	}
+}
+
+func TestParseIntent(t *testing.T) {
+	tests := map[string]icc.Intent{
+		"perceptual":            icc.IntentPerceptual,
+		"Relative":              icc.IntentRelativeColorimetric,
+		"relative-colorimetric": icc.IntentRelativeColorimetric,
+		"saturation":            icc.IntentSaturation,
+		"AbsoluteColorimetric":  icc.IntentAbsoluteColorimetric,
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := icc.ParseIntent(input)
+			if err != nil || got != want {
+				t.Errorf("ParseIntent(%q) = %v, %v, want %v", input, got, err, want)
+			}
+		})
+	}
+
+	if _, err := icc.ParseIntent("vivid"); err == nil {
+		t.Error("ParseIntent(\"vivid\") error = nil")
+	}
+}
@@ -0,0 +1,322 @@
+package icc
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// pipeline transforms a vector of normalized (0.0-1.0) components.
+type pipeline func([]float64) []float64
+
+// clut is a multi-dimensional color lookup table evaluated with multilinear interpolation.
+type clut struct {
+	grid    []int     // Grid points per input dimension
+	outputs int       // Output channels per grid point
+	data    []float64 // Normalized outputs, first input dimension varying slowest
+}
+
+// parseCLUT reads a lookup table with the given grid and entry size in bytes.
+func parseCLUT(data []byte, grid []int, outputs, precision int) (*clut, error) {
+	// Check the size after each dimension, so huge grids cannot overflow n
+	n := outputs
+	for i, g := range grid {
+		if g < 1 {
+			return nil, fmt.Errorf("%w: CLUT dimension %d has %d grid points", ErrInvalidProfile, i, g)
+		}
+		n *= g
+		if n*precision > len(data) {
+			return nil, fmt.Errorf("%w: CLUT out of bounds", ErrInvalidProfile)
+		}
+	}
+
+	c := &clut{grid: grid, outputs: outputs, data: make([]float64, n)}
+	for i := range c.data {
+		if precision == 1 {
+			c.data[i] = float64(data[i]) / 255
+		} else {
+			c.data[i] = float64(binary.BigEndian.Uint16(data[2*i:])) / 65535
+		}
+	}
+	return c, nil
+}
+
+func (c *clut) eval(in []float64) []float64 {
+	dims := len(c.grid)
+	lo := make([]int, dims)
+	frac := make([]float64, dims)
+	for i, g := range c.grid {
+		pos := clamp01(in[i]) * float64(g-1)
+		lo[i] = int(pos)
+		if lo[i] >= g-1 {
+			lo[i] = max(g-2, 0)
+		}
+		frac[i] = pos - float64(lo[i])
+	}
+
+	// Strides with the last input dimension varying fastest
+	strides := make([]int, dims)
+	stride := c.outputs
+	for i := dims - 1; i >= 0; i-- {
+		strides[i] = stride
+		stride *= c.grid[i]
+	}
+
+	out := make([]float64, c.outputs)
+	for corner := range 1 << dims {
+		weight := 1.0
+		index := 0
+		for i := range dims {
+			if corner&(1<<i) != 0 {
+				weight *= frac[i]
+				index += min(lo[i]+1, c.grid[i]-1) * strides[i]
+			} else {
+				weight *= 1 - frac[i]
+				index += lo[i] * strides[i]
+			}
+		}
+		if weight == 0 {
+			continue
+		}
+		for o := range out {
+			out[o] += weight * c.data[index+o]
+		}
+	}
+	return out
+}
+
+// matrix3x4 is a 3x3 matrix with an offset, as used by lutAToB and lutBToA tags.
+type matrix3x4 [12]float64
+
+func (m matrix3x4) apply(in []float64) []float64 {
+	return []float64{
+		m[0]*in[0] + m[1]*in[1] + m[2]*in[2] + m[9],
+		m[3]*in[0] + m[4]*in[1] + m[5]*in[2] + m[10],
+		m[6]*in[0] + m[7]*in[1] + m[8]*in[2] + m[11],
+	}
+}
+
+func parseMatrix(data []byte, n int) (matrix3x4, error) {
+	var m matrix3x4
+	if len(data) < 4*n {
+		return m, fmt.Errorf("%w: matrix out of bounds", ErrInvalidProfile)
+	}
+	for i := range n {
+		m[i] = s15Fixed16(int32(binary.BigEndian.Uint32(data[4*i:])))
+	}
+	return m, nil
+}
+
+// parseLut reads a lut8, lut16, lutAToB or lutBToA tag that maps inputs channels to
+// outputs channels. xyzInput reports whether the tag's input is PCS XYZ, which enables
+// the lut8 and lut16 matrix.
+func parseLut(data []byte, inputs, outputs int, xyzInput bool) (pipeline, error) {
+	if len(data) < 32 {
+		return nil, fmt.Errorf("%w: short LUT", ErrInvalidProfile)
+	}
+	if in, out := int(data[8]), int(data[9]); in != inputs || out != outputs {
+		return nil, fmt.Errorf("%w: LUT with %d inputs and %d outputs, want %d and %d",
+			ErrInvalidProfile, in, out, inputs, outputs)
+	}
+
+	switch tagType(data) {
+	case typeLut8, typeLut16:
+		return parseLutN(data, xyzInput)
+	case typeLutAToB:
+		return parseLutAB(data, true)
+	case typeLutBToA:
+		return parseLutAB(data, false)
+	default:
+		return nil, fmt.Errorf("%w: unsupported LUT type %q", ErrInvalidProfile, tagType(data))
+	}
+}
+
+// parseLutN reads a lut8Type (mft1) or lut16Type (mft2) tag.
+func parseLutN(data []byte, xyzInput bool) (pipeline, error) {
+	in, out, points := int(data[8]), int(data[9]), int(data[10])
+	if in == 0 || out == 0 || in > 15 || out > 15 {
+		return nil, fmt.Errorf("%w: LUT with %d inputs and %d outputs", ErrInvalidProfile, in, out)
+	}
+
+	m, err := parseMatrix(data[12:], 9)
+	if err != nil {
+		return nil, err
+	}
+
+	precision, inEntries, outEntries, offset := 1, 256, 256, 48
+	if tagType(data) == typeLut16 {
+		if len(data) < 52 {
+			return nil, fmt.Errorf("%w: short lut16", ErrInvalidProfile)
+		}
+		precision = 2
+		inEntries = int(binary.BigEndian.Uint16(data[48:]))
+		outEntries = int(binary.BigEndian.Uint16(data[50:]))
+		offset = 52
+		if inEntries < 2 || outEntries < 2 {
+			return nil, fmt.Errorf("%w: lut16 tables need at least 2 entries", ErrInvalidProfile)
+		}
+	}
+
+	readTables := func(n, entries int) ([]curve, error) {
+		size := n * entries * precision
+		if offset+size > len(data) {
+			return nil, fmt.Errorf("%w: LUT tables out of bounds", ErrInvalidProfile)
+		}
+		curves := make([]curve, n)
+		for i := range curves {
+			t := make(tableCurve, entries)
+			for j := range t {
+				pos := offset + (i*entries+j)*precision
+				if precision == 1 {
+					t[j] = float64(data[pos]) / 255
+				} else {
+					t[j] = float64(binary.BigEndian.Uint16(data[pos:])) / 65535
+				}
+			}
+			curves[i] = t
+		}
+		offset += size
+		return curves, nil
+	}
+
+	input, err := readTables(in, inEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	grid := make([]int, in)
+	for i := range grid {
+		grid[i] = points
+	}
+	table, err := parseCLUT(data[offset:], grid, out, precision)
+	if err != nil {
+		return nil, err
+	}
+	offset += len(table.data) * precision
+
+	output, err := readTables(out, outEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	useMatrix := xyzInput && in == 3 && m != (matrix3x4{1, 0, 0, 0, 1, 0, 0, 0, 1})
+
+	return func(values []float64) []float64 {
+		if useMatrix {
+			values = m.apply(values)
+		}
+		return applyCurves(output, table.eval(applyCurves(input, values)))
+	}, nil
+}
+
+// parseLutAB reads a lutAToBType (mAB) or lutBToAType (mBA) tag.
+func parseLutAB(data []byte, aToB bool) (pipeline, error) {
+	in, out := int(data[8]), int(data[9])
+	if in == 0 || out == 0 || in > 15 || out > 15 {
+		return nil, fmt.Errorf("%w: LUT with %d inputs and %d outputs", ErrInvalidProfile, in, out)
+	}
+
+	offsetB := binary.BigEndian.Uint32(data[12:])
+	offsetMatrix := binary.BigEndian.Uint32(data[16:])
+	offsetM := binary.BigEndian.Uint32(data[20:])
+	offsetCLUT := binary.BigEndian.Uint32(data[24:])
+	offsetA := binary.BigEndian.Uint32(data[28:])
+
+	for _, offset := range []uint32{offsetB, offsetMatrix, offsetM, offsetCLUT, offsetA} {
+		if uint64(offset) >= uint64(len(data)) {
+			return nil, fmt.Errorf("%w: LUT element offset %d out of bounds", ErrInvalidProfile, offset)
+		}
+	}
+	if offsetB == 0 {
+		return nil, fmt.Errorf("%w: LUT without B curves", ErrInvalidProfile)
+	}
+
+	// The B side is the PCS side: outputs for AToB, inputs for BToA
+	pcsChannels, deviceChannels := out, in
+	if !aToB {
+		pcsChannels, deviceChannels = in, out
+	}
+
+	b, err := parseCurves(data[offsetB:], pcsChannels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read B curves: %w", err)
+	}
+
+	var mCurves, aCurves []curve
+	var m *matrix3x4
+	var table *clut
+
+	if offsetMatrix != 0 && pcsChannels == 3 {
+		parsed, err := parseMatrix(data[offsetMatrix:], 12)
+		if err != nil {
+			return nil, err
+		}
+		m = &parsed
+	}
+	if offsetM != 0 {
+		if mCurves, err = parseCurves(data[offsetM:], pcsChannels); err != nil {
+			return nil, fmt.Errorf("failed to read M curves: %w", err)
+		}
+	}
+	if offsetCLUT != 0 {
+		clutData := data[offsetCLUT:]
+		if len(clutData) < 20 {
+			return nil, fmt.Errorf("%w: short CLUT", ErrInvalidProfile)
+		}
+		grid := make([]int, in)
+		for i := range grid {
+			grid[i] = int(clutData[i])
+		}
+		precision := int(clutData[16])
+		if precision != 1 && precision != 2 {
+			return nil, fmt.Errorf("%w: CLUT precision %d", ErrInvalidProfile, precision)
+		}
+		if table, err = parseCLUT(clutData[20:], grid, out, precision); err != nil {
+			return nil, err
+		}
+	} else if in != out {
+		return nil, fmt.Errorf("%w: LUT without CLUT maps %d channels to %d", ErrInvalidProfile, in, out)
+	}
+	if offsetA != 0 {
+		if aCurves, err = parseCurves(data[offsetA:], deviceChannels); err != nil {
+			return nil, fmt.Errorf("failed to read A curves: %w", err)
+		}
+	}
+
+	pcsSide := func(values []float64) []float64 {
+		if aToB {
+			if mCurves != nil {
+				values = applyCurves(mCurves, values)
+			}
+			if m != nil {
+				values = m.apply(values)
+			}
+			return applyCurves(b, values)
+		}
+		values = applyCurves(b, values)
+		if m != nil {
+			values = m.apply(values)
+		}
+		if mCurves != nil {
+			values = applyCurves(mCurves, values)
+		}
+		return values
+	}
+
+	deviceSide := func(values []float64) []float64 {
+		if aToB && aCurves != nil {
+			values = applyCurves(aCurves, values)
+		}
+		if table != nil {
+			values = table.eval(values)
+		}
+		if !aToB && aCurves != nil {
+			values = applyCurves(aCurves, values)
+		}
+		return values
+	}
+
+	if aToB {
+		return func(values []float64) []float64 { return pcsSide(deviceSide(values)) }, nil
+	}
+	return func(values []float64) []float64 { return deviceSide(pcsSide(values)) }, nil
+}
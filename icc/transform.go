@@ -0,0 +1,413 @@
+package icc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kennyp/palette/color"
+)
+
+//go:generate go tool stringer -type=Intent -trimprefix=Intent
+type Intent uint32 // Rendering intent
+
+const (
+	IntentPerceptual           Intent = 0
+	IntentRelativeColorimetric Intent = 1
+	IntentSaturation           Intent = 2
+	IntentAbsoluteColorimetric Intent = 3
+)
+
+// ParseIntent parses a rendering intent name such as "perceptual", "relative",
+// "saturation" or "absolute". Names are case insensitive and may include a
+// "-colorimetric" suffix.
+func ParseIntent(s string) (Intent, error) {
+	name := strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s))
+	switch strings.TrimSuffix(name, "colorimetric") {
+	case "perceptual":
+		return IntentPerceptual, nil
+	case "relative":
+		return IntentRelativeColorimetric, nil
+	case "saturation":
+		return IntentSaturation, nil
+	case "absolute":
+		return IntentAbsoluteColorimetric, nil
+	default:
+		return 0, fmt.Errorf("invalid rendering intent: %s (must be one of: perceptual, relative, saturation, absolute)", s)
+	}
+}
+
+// Transform converts colors from a source profile to a destination profile.
+//
+// Either profile may be nil. A nil source means colors are taken at their own colorimetric
+// value, so an sRGB or Lab color is converted into the destination profile as is. A nil
+// destination produces colorimetric LAB64 colors from the source device values.
+type Transform struct {
+	Source      *Profile
+	Destination *Profile
+	Intent      Intent
+
+	toPCS   func([]float64) color.XYZ
+	fromPCS func(color.XYZ) []float64
+}
+
+// NewTransform creates a transform between two profiles using the given rendering intent.
+// Profiles without a table for the intent fall back to their perceptual table, and
+// matrix/TRC profiles are always converted colorimetrically.
+func NewTransform(src, dst *Profile, intent Intent) (*Transform, error) {
+	if src == nil && dst == nil {
+		return nil, errors.New("a source or destination profile is required")
+	}
+	if intent > IntentAbsoluteColorimetric {
+		return nil, fmt.Errorf("invalid rendering intent: %d", intent)
+	}
+
+	t := &Transform{Source: src, Destination: dst, Intent: intent}
+
+	if src != nil {
+		toPCS, err := src.toPCS(intent)
+		if err != nil {
+			return nil, fmt.Errorf("source profile: %w", err)
+		}
+		t.toPCS = toPCS
+	}
+
+	if dst != nil {
+		fromPCS, err := dst.fromPCS(intent)
+		if err != nil {
+			return nil, fmt.Errorf("destination profile: %w", err)
+		}
+		t.fromPCS = fromPCS
+	}
+
+	return t, nil
+}
+
+// Apply converts device values, each in the range 0.0-1.0, from the source profile to
+// the destination profile. Both profiles must be set.
+func (t *Transform) Apply(values []float64) ([]float64, error) {
+	if t.Source == nil || t.Destination == nil {
+		return nil, errors.New("apply requires both a source and a destination profile")
+	}
+	if n := t.Source.ColorSpace.Channels(); len(values) != n {
+		return nil, fmt.Errorf("expected %d %s values, got %d", n, t.Source.ColorSpace, len(values))
+	}
+	return t.fromPCS(t.toPCS(values)), nil
+}
+
+// Convert converts a color through the transform. Device values are read from the color in
+// the source profile's color space, e.g. with ToCMYK64 for a CMYK profile, and the result is
+// a color in the destination profile's color space. Alpha is preserved.
+func (t *Transform) Convert(c color.Color) (color.Color, error) {
+	if alpha, ok := c.(color.AlphaColor); ok {
+		converted, err := t.Convert(alpha.Color)
+		if err != nil {
+			return nil, err
+		}
+		return color.WithAlpha(converted, alpha.Alpha), nil
+	}
+
+	var pcs color.XYZ
+	if t.Source == nil {
+		pcs = c.ToXYZ().Adapt(color.D65, t.Destination.Illuminant, color.AdaptationBradford)
+	} else {
+		values, err := deviceValues(c, t.Source.ColorSpace)
+		if err != nil {
+			return nil, err
+		}
+		pcs = t.toPCS(values)
+	}
+
+	if t.Destination == nil {
+		return pcs.Adapt(t.Source.Illuminant, color.D65, color.AdaptationBradford).ToLAB64(), nil
+	}
+
+	return deviceColor(t.fromPCS(pcs), t.Destination.ColorSpace)
+}
+
+// deviceValues reads the components of c in a profile's data color space.
+func deviceValues(c color.Color, space Signature) ([]float64, error) {
+	switch space {
+	case ColorSpaceRGB:
+		rgb := c.ToRGB64()
+		return []float64{rgb.R, rgb.G, rgb.B}, nil
+	case ColorSpaceCMYK:
+		cmyk := c.ToCMYK64()
+		return []float64{cmyk.C, cmyk.M, cmyk.Y, cmyk.K}, nil
+	case ColorSpaceGray:
+		return []float64{c.ToXYZ().ToGray().Y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported device color space %q", space)
+	}
+}
+
+// deviceColor creates a color from components in a profile's data color space.
+func deviceColor(values []float64, space Signature) (color.Color, error) {
+	switch space {
+	case ColorSpaceRGB:
+		return color.NewRGB64(values[0], values[1], values[2]), nil
+	case ColorSpaceCMYK:
+		return color.NewCMYK64(values[0], values[1], values[2], values[3]), nil
+	case ColorSpaceGray:
+		return color.NewGray(values[0]), nil
+	default:
+		return nil, fmt.Errorf("unsupported device color space %q", space)
+	}
+}
+
+// toPCS builds the conversion from device values to PCS XYZ relative to the profile illuminant.
+func (p *Profile) toPCS(intent Intent) (func([]float64) color.XYZ, error) {
+	if err := p.checkDeviceSpace(); err != nil {
+		return nil, err
+	}
+
+	var convert func([]float64) color.XYZ
+
+	if data, ok := p.lutTag(tagAToB0, intent); ok {
+		lut, err := parseLut(data, p.ColorSpace.Channels(), 3, p.ColorSpace == ColorSpaceXYZ)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read AToB table: %w", err)
+		}
+		encoding := p.encoding(tagType(data))
+		convert = func(values []float64) color.XYZ {
+			return p.decodePCS(lut(values), encoding)
+		}
+	} else if p.ColorSpace == ColorSpaceGray {
+		trc, err := p.curveTag(tagGrayTRC)
+		if err != nil {
+			return nil, err
+		}
+		convert = func(values []float64) color.XYZ {
+			return p.grayToPCS(trc.eval(values[0]))
+		}
+	} else {
+		m, trc, err := p.matrixTRC()
+		if err != nil {
+			return nil, err
+		}
+		convert = func(values []float64) color.XYZ {
+			r, g, b := m.Apply(trc[0].eval(values[0]), trc[1].eval(values[1]), trc[2].eval(values[2]))
+			return color.XYZ{X: r, Y: g, Z: b}
+		}
+	}
+
+	if intent == IntentAbsoluteColorimetric {
+		scale, err := p.absoluteScale()
+		if err != nil {
+			return nil, err
+		}
+		return func(values []float64) color.XYZ {
+			c := convert(values)
+			return color.XYZ{X: c.X * scale.X, Y: c.Y * scale.Y, Z: c.Z * scale.Z}
+		}, nil
+	}
+
+	return convert, nil
+}
+
+// fromPCS builds the conversion from PCS XYZ relative to the profile illuminant to device values.
+func (p *Profile) fromPCS(intent Intent) (func(color.XYZ) []float64, error) {
+	if err := p.checkDeviceSpace(); err != nil {
+		return nil, err
+	}
+
+	var convert func(color.XYZ) []float64
+
+	if data, ok := p.lutTag(tagBToA0, intent); ok {
+		lut, err := parseLut(data, 3, p.ColorSpace.Channels(), p.PCS == ColorSpaceXYZ)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read BToA table: %w", err)
+		}
+		encoding := p.encoding(tagType(data))
+		convert = func(c color.XYZ) []float64 {
+			return lut(p.encodePCS(c, encoding))
+		}
+	} else if p.ColorSpace == ColorSpaceGray {
+		trc, err := p.curveTag(tagGrayTRC)
+		if err != nil {
+			return nil, err
+		}
+		convert = func(c color.XYZ) []float64 {
+			return []float64{invertCurve(trc, p.pcsToGray(c))}
+		}
+	} else {
+		m, trc, err := p.matrixTRC()
+		if err != nil {
+			return nil, err
+		}
+		inverse, ok := m.Inverse()
+		if !ok {
+			return nil, fmt.Errorf("%w: colorant matrix is not invertible", ErrInvalidProfile)
+		}
+		convert = func(c color.XYZ) []float64 {
+			r, g, b := inverse.Apply(c.X, c.Y, c.Z)
+			return []float64{invertCurve(trc[0], r), invertCurve(trc[1], g), invertCurve(trc[2], b)}
+		}
+	}
+
+	if intent == IntentAbsoluteColorimetric {
+		scale, err := p.absoluteScale()
+		if err != nil {
+			return nil, err
+		}
+		return func(c color.XYZ) []float64 {
+			return convert(color.XYZ{X: c.X / scale.X, Y: c.Y / scale.Y, Z: c.Z / scale.Z})
+		}, nil
+	}
+
+	return convert, nil
+}
+
+func (p *Profile) checkDeviceSpace() error {
+	switch p.Class {
+	case ClassLink, ClassNamedColor:
+		return fmt.Errorf("%s profiles are not supported", p.Class)
+	}
+	if p.ColorSpace.Channels() == 0 {
+		return fmt.Errorf("unsupported device color space %q", p.ColorSpace)
+	}
+	return nil
+}
+
+// lutTag returns the table for the intent, falling back to the perceptual (0) table.
+// Absolute colorimetric uses the relative colorimetric table.
+func (p *Profile) lutTag(base Signature, intent Intent) ([]byte, bool) {
+	if intent == IntentAbsoluteColorimetric {
+		intent = IntentRelativeColorimetric
+	}
+	if data, ok := p.tags[base+Signature(intent)]; ok {
+		return data, true
+	}
+	data, ok := p.tags[base]
+	return data, ok
+}
+
+func (p *Profile) curveTag(sig Signature) (curve, error) {
+	data, ok := p.tags[sig]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %q tag", ErrInvalidProfile, sig)
+	}
+	c, _, err := parseCurve(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", sig, err)
+	}
+	return c, nil
+}
+
+// matrixTRC reads the colorant matrix and tone curves of a three-component matrix/TRC profile.
+func (p *Profile) matrixTRC() (color.Matrix3, [3]curve, error) {
+	var m color.Matrix3
+	var trc [3]curve
+
+	if p.ColorSpace.Channels() != 3 {
+		return m, trc, fmt.Errorf("%w: %s profile has no AToB or BToA table", ErrInvalidProfile, p.ColorSpace)
+	}
+
+	for i, sig := range []Signature{tagRedColorant, tagGreenColorant, tagBlueColorant} {
+		data, ok := p.tags[sig]
+		if !ok {
+			return m, trc, fmt.Errorf("%w: missing %q tag", ErrInvalidProfile, sig)
+		}
+		colorant, err := parseXYZ(data)
+		if err != nil {
+			return m, trc, fmt.Errorf("failed to read %q: %w", sig, err)
+		}
+		m[0][i], m[1][i], m[2][i] = colorant.X, colorant.Y, colorant.Z
+	}
+
+	for i, sig := range []Signature{tagRedTRC, tagGreenTRC, tagBlueTRC} {
+		c, err := p.curveTag(sig)
+		if err != nil {
+			return m, trc, err
+		}
+		trc[i] = c
+	}
+
+	return m, trc, nil
+}
+
+// absoluteScale returns the ratio of the media white to the PCS illuminant, which maps
+// relative colorimetric values to absolute ones.
+func (p *Profile) absoluteScale() (color.XYZ, error) {
+	data, ok := p.tags[tagMediaWhite]
+	if !ok {
+		return color.XYZ{X: 1, Y: 1, Z: 1}, nil
+	}
+	white, err := parseXYZ(data)
+	if err != nil {
+		return color.XYZ{}, fmt.Errorf("failed to read media white point: %w", err)
+	}
+	if white.X <= 0 || white.Y <= 0 || white.Z <= 0 {
+		return color.XYZ{}, fmt.Errorf("%w: media white point %v", ErrInvalidProfile, white)
+	}
+	return color.XYZ{X: white.X / p.Illuminant.X, Y: white.Y / p.Illuminant.Y, Z: white.Z / p.Illuminant.Z}, nil
+}
+
+// pcsEncoding is the way a table tag encodes PCS values as 0.0-1.0 components.
+type pcsEncoding int
+
+const (
+	encodingXYZ       pcsEncoding = iota // u1Fixed15 XYZ
+	encodingLab                          // ICC v4 Lab: L 0-100, a/b -128-127 over the full range
+	encodingLabLegacy                    // ICC v2 16-bit Lab: 0xFF00 is L 100 and a/b 127
+)
+
+func (p *Profile) encoding(lutType Signature) pcsEncoding {
+	switch {
+	case p.PCS == ColorSpaceXYZ:
+		return encodingXYZ
+	case lutType == typeLut16:
+		return encodingLabLegacy
+	default:
+		return encodingLab
+	}
+}
+
+// xyzScale converts u1Fixed15 XYZ stored in 16 bits to a 0.0-1.0 component.
+const xyzScale = 65535.0 / 32768
+
+// labLegacyScale converts ICC v2 16-bit Lab to the v4 encoding.
+const labLegacyScale = 65535.0 / 65280
+
+func (p *Profile) decodePCS(values []float64, encoding pcsEncoding) color.XYZ {
+	switch encoding {
+	case encodingXYZ:
+		return color.XYZ{X: values[0] * xyzScale, Y: values[1] * xyzScale, Z: values[2] * xyzScale}
+	case encodingLabLegacy:
+		values = []float64{values[0] * labLegacyScale, values[1] * labLegacyScale, values[2] * labLegacyScale}
+	}
+	return color.LAB64{L: values[0] * 100, A: values[1]*255 - 128, B: values[2]*255 - 128, White: p.Illuminant}.RelativeXYZ()
+}
+
+func (p *Profile) encodePCS(c color.XYZ, encoding pcsEncoding) []float64 {
+	if encoding == encodingXYZ {
+		return []float64{clamp01(c.X / xyzScale), clamp01(c.Y / xyzScale), clamp01(c.Z / xyzScale)}
+	}
+
+	lab := c.ToLAB64WithWhite(p.Illuminant)
+	values := []float64{lab.L / 100, (lab.A + 128) / 255, (lab.B + 128) / 255}
+	if encoding == encodingLabLegacy {
+		for i := range values {
+			values[i] /= labLegacyScale
+		}
+	}
+	for i := range values {
+		values[i] = clamp01(values[i])
+	}
+	return values
+}
+
+// grayToPCS converts the output of a gray TRC, which is Y for XYZ profiles and L*/100 for Lab.
+func (p *Profile) grayToPCS(v float64) color.XYZ {
+	if p.PCS == ColorSpaceLab {
+		return color.LAB64{L: v * 100, White: p.Illuminant}.RelativeXYZ()
+	}
+	return color.XYZ{X: p.Illuminant.X * v, Y: p.Illuminant.Y * v, Z: p.Illuminant.Z * v}
+}
+
+func (p *Profile) pcsToGray(c color.XYZ) float64 {
+	if p.PCS == ColorSpaceLab {
+		return c.ToLAB64WithWhite(p.Illuminant).L / 100
+	}
+	return c.Y / p.Illuminant.Y
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/kennyp/palette/adobe/colorbook"
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 )
 
@@ -50,14 +51,19 @@ func (i *Importer) Import(r io.Reader) (*palette.Palette, error) {
 	p.SetMetadata("color_type", acb.ColorType)
 	p.SetMetadata("format", "Adobe Color Book")
 
-	// Convert colors
+	// Convert colors; spot books describe named inks, with the book values as the alternate
 	for _, c := range acb.Colors {
 		paletteColor, err := convertAdobeColor(c, acb.ColorType)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert color %s: %w", c.Name, err)
 		}
 
+		if acb.IsSpot() {
+			paletteColor = color.NewSpot(acb.Prefix+c.Name+acb.Postfix, acb.Title, paletteColor)
+		}
+
 		p.Add(paletteColor, c.Name)
+		p.Colors[p.Len()-1].SetMetadata(palette.MetadataKey, string(c.Key[:]))
 	}
 
 	return p, nil
@@ -73,6 +79,19 @@ func (i *Importer) SupportedFormats() []string {
 	return []string{".acb", "colorbook"}
 }
 
+// Losses reports colors whose alpha cannot be stored in ACB files, and colors whose
+// RGB, CMYK or Lab values are rounded to the book's 8-bit components.
+func (e *Exporter) Losses(p *palette.Palette) []paletteio.Loss {
+	colorType := bookColorType(p)
+	return append(paletteio.AlphaLosses(p), paletteio.PrecisionLosses(p, func(c color.Color) (color.Color, error) {
+		adobeColor, err := convertToAdobeColor(c, "", 0, colorType)
+		if err != nil {
+			return nil, err
+		}
+		return convertAdobeColor(adobeColor, colorType)
+	})...)
+}
+
 // Exporter implements exporting to Adobe Color Book (.acb) files.
 type Exporter struct{}
 
@@ -130,14 +149,7 @@ func (e *Exporter) Export(p *palette.Palette, w io.Writer) error {
 		}
 	}
 
-	if colorType, ok := p.GetMetadata("color_type"); ok {
-		if ct, ok := colorType.(colorbook.ColorType); ok {
-			acb.ColorType = ct
-		}
-	} else {
-		// Default to RGB if no color type specified
-		acb.ColorType = colorbook.ColorTypeRGB
-	}
+	acb.ColorType = bookColorType(p)
 
 	// Convert colors
 	acb.Colors = make([]*colorbook.Color, 0, p.Len())
@@ -147,10 +159,18 @@ func (e *Exporter) Export(p *palette.Palette, w io.Writer) error {
 			return fmt.Errorf("failed to get color at index %d: %w", i, err)
 		}
 
+		// Any spot ink makes this a spot book; otherwise the color type decides
+		if _, ok := color.AsSpot(namedColor.Color); ok {
+			acb.SpotFunction = colorbook.SpotFunctionSpot
+		}
+
 		adobeColor, err := convertToAdobeColor(namedColor.Color, namedColor.Name, i, acb.ColorType)
 		if err != nil {
 			return fmt.Errorf("failed to convert color %s: %w", namedColor.Name, err)
 		}
+		if key, ok := colorKey(namedColor); ok {
+			adobeColor.Key = key
+		}
 
 		acb.Colors = append(acb.Colors, adobeColor)
 	}
@@ -181,6 +201,17 @@ func (e *Exporter) SupportedFormats() []string {
 
 // Helper functions
 
+// bookColorType returns the ACB color type stored in the palette's metadata, or RGB if
+// none is specified.
+func bookColorType(p *palette.Palette) colorbook.ColorType {
+	if colorType, ok := p.GetMetadata("color_type"); ok {
+		if ct, ok := colorType.(colorbook.ColorType); ok {
+			return ct
+		}
+	}
+	return colorbook.ColorTypeRGB
+}
+
 // convertAdobeColor converts an Adobe Color Book color to a palette color.
 func convertAdobeColor(c *colorbook.Color, colorType colorbook.ColorType) (color.Color, error) {
 	switch colorType {
@@ -190,18 +221,18 @@ func convertAdobeColor(c *colorbook.Color, colorType colorbook.ColorType) (color
 
 	case colorbook.ColorTypeCMYK:
 		// Adobe CMYK: 0=100%, 255=0% (inverted from percentage)
-		cy := uint8(math.Round((255 - float64(c.Components[0])) / 255.0 * 100))
-		mg := uint8(math.Round((255 - float64(c.Components[1])) / 255.0 * 100))
-		ye := uint8(math.Round((255 - float64(c.Components[2])) / 255.0 * 100))
-		k := uint8(math.Round((255 - float64(c.Components[3])) / 255.0 * 100))
-		return color.NewCMYK(cy, mg, ye, k), nil
+		cy := (255 - float64(c.Components[0])) / 255.0
+		mg := (255 - float64(c.Components[1])) / 255.0
+		ye := (255 - float64(c.Components[2])) / 255.0
+		k := (255 - float64(c.Components[3])) / 255.0
+		return color.NewCMYK64(cy, mg, ye, k), nil
 
 	case colorbook.ColorTypeLab:
-		// Adobe LAB: L=0-255 (maps to 0-100%), a/b=0-255 (maps to -128 to 127)
-		l := int8(math.Round(float64(c.Components[0]) / 2.55))
-		a := int8(int(c.Components[1]) - 128)
-		b := int8(int(c.Components[2]) - 128)
-		return color.NewLAB(l, a, b), nil
+		// Adobe LAB: L=0-255 (maps to 0-100%), a/b=0-255 (maps to -128 to 127), D50-relative
+		l := float64(c.Components[0]) / 2.55
+		a := float64(c.Components[1]) - 128
+		b := float64(c.Components[2]) - 128
+		return color.NewLAB64WithWhite(l, a, b, color.D50), nil
 
 	default:
 		return nil, fmt.Errorf("unsupported color type: %v", colorType)
@@ -221,21 +252,21 @@ func convertToAdobeColor(c color.Color, name string, index int, targetType color
 		adobeColor.Components = [4]byte{rgb.R, rgb.G, rgb.B, 0}
 
 	case colorbook.ColorTypeCMYK:
-		cmyk := c.ToCMYK()
+		cmyk := c.ToCMYK64()
 		// Adobe CMYK: 0=100%, 255=0% (inverted from percentage)
-		cy := uint8(255 - math.Round(float64(cmyk.C)/100.0*255))
-		mg := uint8(255 - math.Round(float64(cmyk.M)/100.0*255))
-		ye := uint8(255 - math.Round(float64(cmyk.Y)/100.0*255))
-		k := uint8(255 - math.Round(float64(cmyk.K)/100.0*255))
+		cy := uint8(255 - math.Round(clamp(cmyk.C, 0, 1)*255))
+		mg := uint8(255 - math.Round(clamp(cmyk.M, 0, 1)*255))
+		ye := uint8(255 - math.Round(clamp(cmyk.Y, 0, 1)*255))
+		k := uint8(255 - math.Round(clamp(cmyk.K, 0, 1)*255))
 		adobeColor.Components = [4]byte{cy, mg, ye, k}
 
 	case colorbook.ColorTypeLab:
-		lab := c.ToLAB()
-		// Adobe LAB: L=0-255 (from 0-100%), a/b=0-255 (from -128 to 127)
+		lab := c.ToLAB64().Adapt(color.D50, color.AdaptationBradford)
+		// Adobe LAB: L=0-255 (from 0-100%), a/b=0-255 (from -128 to 127), D50-relative
 		adobeColor.Components = [4]byte{
-			byte(math.Round(float64(lab.L) * 2.55)),
-			byte(int(lab.A) + 128),
-			byte(int(lab.B) + 128),
+			byte(math.Round(clamp(lab.L, 0, 100) * 2.55)),
+			byte(math.Round(clamp(lab.A, -128, 127)) + 128),
+			byte(math.Round(clamp(lab.B, -128, 127)) + 128),
 			0,
 		}
 
@@ -246,6 +277,11 @@ func convertToAdobeColor(c color.Color, name string, index int, targetType color
 	return adobeColor, nil
 }
 
+// clamp restricts v to the range [min, max].
+func clamp(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
+
 // generateColorKey creates a 6-character catalog code from the color name and index.
 // Format: First 3 chars (uppercased) + index padded to 3 digits (e.g., "RED001", "BLU042").
 func generateColorKey(name string, index int) [6]byte {
@@ -267,6 +303,24 @@ func generateColorKey(name string, index int) [6]byte {
 	return key
 }
 
+// colorKey returns the catalog code stored in a color's metadata, such as the key of
+// an imported ACB color. Codes longer than 6 bytes are not used; shorter codes are
+// padded with spaces.
+func colorKey(c palette.NamedColor) ([6]byte, bool) {
+	value, ok := c.GetMetadata(palette.MetadataKey)
+	if !ok {
+		return [6]byte{}, false
+	}
+	s, ok := value.(string)
+	if !ok || s == "" || len(s) > 6 {
+		return [6]byte{}, false
+	}
+
+	key := [6]byte{' ', ' ', ' ', ' ', ' ', ' '}
+	copy(key[:], s)
+	return key, true
+}
+
 // generateBookID creates a unique BookID for user-generated palettes.
 // Uses FNV-1a hash of palette name, color count, and first color name.
 // Returns a value in range 4000-65535 to avoid Adobe's reserved range (3000-3022).
@@ -2,12 +2,14 @@ package colorbook_test
 
 import (
 	"bytes"
+	"math"
 	"os"
 	"strings"
 	"testing"
 
 	adobeColorbook "github.com/kennyp/palette/adobe/colorbook"
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/io/colorbook"
 	"github.com/kennyp/palette/palette"
 )
@@ -280,7 +282,7 @@ func TestLABRoundTrip(t *testing.T) {
 			// Create palette with LAB color
 			p := palette.New("LAB Test")
 			p.SetMetadata("color_type", adobeColorbook.ColorTypeLab)
-			p.Add(color.NewLAB(tt.l, tt.a, tt.b), "Test Color")
+			p.Add(color.NewLAB64WithWhite(float64(tt.l), float64(tt.a), float64(tt.b), color.D50), "Test Color")
 
 			// Export to ACB
 			exporter := colorbook.NewExporter()
@@ -301,12 +303,25 @@ func TestLABRoundTrip(t *testing.T) {
 				t.Fatalf("Expected 1 color, got %d", imported.Len())
 			}
 
+			// ACB Lab books are spot books, so the Lab value is the alternate.
+			// It is D50-relative, so compare without adapting to D65
 			nc, _ := imported.Get(0)
-			lab := nc.Color.ToLAB()
+			spot, ok := color.AsSpot(nc.Color)
+			if !ok {
+				t.Fatalf("Expected SpotColor, got %T", nc.Color)
+			}
+			lab, ok := spot.Color.(color.LAB64)
+			if !ok {
+				t.Fatalf("Expected LAB64, got %T", spot.Color)
+			}
+			if lab.WhitePoint() != color.D50 {
+				t.Errorf("White point = %v, want D50", lab.WhitePoint())
+			}
 
-			if lab.L != tt.l || lab.A != tt.a || lab.B != tt.b {
+			l, a, b := int8(math.Round(lab.L)), int8(math.Round(lab.A)), int8(math.Round(lab.B))
+			if l != tt.l || a != tt.a || b != tt.b {
 				t.Errorf("LAB mismatch: got (%d,%d,%d), want (%d,%d,%d)",
-					lab.L, lab.A, lab.B, tt.l, tt.a, tt.b)
+					l, a, b, tt.l, tt.a, tt.b)
 			}
 		})
 	}
@@ -520,6 +535,43 @@ func TestColorKeyGeneration(t *testing.T) {
 	}
 }
 
+func TestColorKeyRoundTrip(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+	p.Add(color.NewRGB(0, 0, 255), "Blue")
+	p.Add(color.NewRGB(0, 255, 0), "Green")
+	p.Colors[0].SetMetadata(palette.MetadataKey, "PMS185")
+	p.Colors[1].SetMetadata(palette.MetadataKey, "B1")
+	p.Colors[2].SetMetadata(palette.MetadataKey, "TOO LONG")
+
+	var buf bytes.Buffer
+	if err := colorbook.NewExporter().Export(p, &buf); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	imported, err := colorbook.NewImporter().Import(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	// Keys that do not fit are regenerated
+	for i, want := range []string{"PMS185", "B1    ", "GRE002"} {
+		nc, _ := imported.Get(i)
+		if key, _ := nc.GetMetadata(palette.MetadataKey); key != want {
+			t.Errorf("Color %d key = %q, want %q", i, key, want)
+		}
+	}
+
+	// Imported keys are written back unchanged
+	var again bytes.Buffer
+	if err := colorbook.NewExporter().Export(imported, &again); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if !bytes.Equal(again.Bytes(), buf.Bytes()) {
+		t.Errorf("Re-exported color book differs from the original")
+	}
+}
+
 func TestImportKnownAdobeFiles(t *testing.T) {
 	tests := map[string]struct {
 		filename   string
@@ -574,3 +626,101 @@ func TestImportKnownAdobeFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestLossesReportsAlpha(t *testing.T) {
+	p := palette.New("Alpha")
+	p.Add(color.NewRGB(255, 0, 0), "Solid")
+	p.Add(color.WithAlpha(color.NewRGB(0, 0, 255), 0.25), "Glass")
+
+	losses := colorbook.NewExporter().Losses(p)
+	if len(losses) != 1 || losses[0].Name != "Glass" || losses[0].Property != paletteio.LossAlpha {
+		t.Errorf("Losses() = %v, want alpha dropped for Glass", losses)
+	}
+}
+
+func TestLossesReportsPrecision(t *testing.T) {
+	tests := map[string]struct {
+		colorType adobeColorbook.ColorType
+		exact     color.Color
+		rounded   color.Color
+	}{
+		"RGB":  {adobeColorbook.ColorTypeRGB, color.NewRGB(255, 128, 0), color.NewRGB64(0.1234, 0.5, 0.9)},
+		"CMYK": {adobeColorbook.ColorTypeCMYK, color.NewCMYK64(0.2, 0.4, 0.6, 0), color.NewCMYK64(0.1, 0.25, 0.333, 0.05)},
+		"Lab":  {adobeColorbook.ColorTypeLab, color.NewLAB64WithWhite(40, 10, -20, color.D50), color.NewLAB64WithWhite(47, 72.5, 43, color.D50)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := palette.New("Precision")
+			p.SetMetadata("color_type", tt.colorType)
+			p.Add(tt.exact, "Exact")
+			p.Add(tt.rounded, "Rounded")
+
+			losses := colorbook.NewExporter().Losses(p)
+			if len(losses) != 1 || losses[0].Name != "Rounded" || losses[0].Property != paletteio.LossPrecision {
+				t.Errorf("Losses() = %v, want full precision dropped for Rounded", losses)
+			}
+		})
+	}
+}
+
+func TestPantoneSpotRoundTrip(t *testing.T) {
+	p := palette.New("PANTONE+ Solid Coated")
+	p.SetMetadata("book_id", adobeColorbook.BookIDPantoneCoated)
+	p.SetMetadata("color_type", adobeColorbook.ColorTypeLab)
+	p.SetMetadata("prefix", "PANTONE ")
+	p.SetMetadata("postfix", " C")
+	p.Add(color.NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", color.NewLAB64WithWhite(47, 72, 43, color.D50)), "185")
+
+	var buf bytes.Buffer
+	if err := colorbook.NewExporter().Export(p, &buf); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if suffix := string(buf.Bytes()[buf.Len()-8:]); suffix != adobeColorbook.SpotFunctionSpot {
+		t.Errorf("Spot function = %q, want %q", suffix, adobeColorbook.SpotFunctionSpot)
+	}
+
+	imported, err := colorbook.NewImporter().Import(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	nc, _ := imported.Get(0)
+	spot, ok := color.AsSpot(nc.Color)
+	if !ok {
+		t.Fatalf("Imported color = %T, want color.SpotColor", nc.Color)
+	}
+	if spot.Ink != "PANTONE 185 C" || spot.Book != "PANTONE+ Solid Coated" {
+		t.Errorf("Imported spot = %q from %q, want PANTONE 185 C from PANTONE+ Solid Coated", spot.Ink, spot.Book)
+	}
+	if nc.Name != "185" {
+		t.Errorf("Imported name = %q, want 185", nc.Name)
+	}
+	if d := color.DeltaE(spot, color.NewLAB64WithWhite(47, 72, 43, color.D50), color.DeltaECIE76); d > 1 {
+		t.Errorf("Imported alternate = %v, ΔE %.2f from the original", spot.Color, d)
+	}
+}
+
+func TestProcessBookIsNotSpot(t *testing.T) {
+	p := palette.New("Process")
+	p.SetMetadata("color_type", adobeColorbook.ColorTypeCMYK)
+	p.Add(color.NewCMYK(0, 100, 100, 0), "Red")
+
+	var buf bytes.Buffer
+	if err := colorbook.NewExporter().Export(p, &buf); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	imported, err := colorbook.NewImporter().Import(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if nc, _ := imported.Get(0); !isProcess(nc.Color) {
+		t.Errorf("Imported color = %T, want a process color", nc.Color)
+	}
+}
+
+func isProcess(c color.Color) bool {
+	_, spot := color.AsSpot(c)
+	return !spot
+}
@@ -3,14 +3,21 @@ package colorswatch
 import (
 	"fmt"
 	"io"
+	"math"
+	"strings"
 
 	"github.com/kennyp/palette/adobe/colorswatch"
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 )
 
 // Importer implements importing Adobe Color Swatch (.aco) files.
-type Importer struct{}
+type Importer struct {
+	// AutoName, if set, names unnamed colors after the nearest color in the set
+	// instead of "Color 1", "Color 2" and so on
+	AutoName *color.NameSet
+}
 
 // NewImporter creates a new Adobe Color Swatch importer.
 func NewImporter() *Importer {
@@ -43,23 +50,34 @@ func (i *Importer) Import(r io.Reader) (*palette.Palette, error) {
 	p.SetMetadata("format", "Adobe Color Swatch")
 
 	// Convert colors
-	for i, c := range acs.Colors {
+	for idx, c := range acs.Colors {
 		paletteColor, err := convertAdobeSwatchColor(c)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert color at index %d: %w", i, err)
+			return nil, fmt.Errorf("failed to convert color at index %d: %w", idx, err)
 		}
 
 		colorName := c.Name
-		if colorName == "" {
-			colorName = fmt.Sprintf("Color %d", i+1)
+		if colorName == "" && i.AutoName == nil {
+			colorName = fmt.Sprintf("Color %d", idx+1)
 		}
 
 		p.Add(paletteColor, colorName)
 	}
 
+	if i.AutoName != nil {
+		p.AutoName(i.AutoName)
+	}
+
 	return p, nil
 }
 
+// WithAutoName returns a copy of the importer that names unnamed colors from set.
+func (i *Importer) WithAutoName(set *color.NameSet) paletteio.Importer {
+	named := *i
+	named.AutoName = set
+	return &named
+}
+
 // CanImport returns true if this importer can handle the given format.
 func (i *Importer) CanImport(format string) bool {
 	return format == ".aco" || format == ".ACO" || format == "colorswatch" || format == "swatch"
@@ -70,6 +88,19 @@ func (i *Importer) SupportedFormats() []string {
 	return []string{".aco", "colorswatch", "swatch"}
 }
 
+// Losses reports colors whose alpha cannot be stored in ACO files, and spot inks
+// from books without an ACO color space.
+func (e *Exporter) Losses(p *palette.Palette) []paletteio.Loss {
+	losses := paletteio.AlphaLosses(p)
+	for _, loss := range paletteio.SpotLosses(p) {
+		spot, _ := color.AsSpot(p.Colors[loss.Index].Color)
+		if _, ok := spotColorSpace(spot.Book); !ok {
+			losses = append(losses, loss)
+		}
+	}
+	return losses
+}
+
 // Exporter implements exporting to Adobe Color Swatch (.aco) files.
 type Exporter struct {
 	// Version specifies the ACO version to export (1 or 2)
@@ -153,54 +184,63 @@ func (e *Exporter) SupportedFormats() []string {
 // Helper functions
 
 // convertAdobeSwatchColor converts an Adobe Color Swatch color to a palette color.
+// Component values are carried at full 16-bit precision using the float-backed color types.
 func convertAdobeSwatchColor(c *colorswatch.Color) (color.Color, error) {
 	switch c.ColorSpace {
 	case colorswatch.ColorSpaceRGB:
-		// Adobe ACO RGB values are 0-65535, convert to 0-255
-		r := uint8(c.Values[0] >> 8)
-		g := uint8(c.Values[1] >> 8)
-		b := uint8(c.Values[2] >> 8)
-		return color.NewRGB(r, g, b), nil
+		// Adobe ACO RGB values are 0-65535
+		return color.NewRGB64(
+			float64(c.Values[0])/65535,
+			float64(c.Values[1])/65535,
+			float64(c.Values[2])/65535,
+		), nil
 
 	case colorswatch.ColorSpaceHSB:
-		// Adobe ACO HSB: H=0-360*182, S=0-100*655, B=0-100*655
-		h := uint16(c.Values[0] / 182)
-		s := uint8(c.Values[1] / 655)
-		b := uint8(c.Values[2] / 655)
-		return color.NewHSB(h, s, b), nil
+		// Adobe ACO HSB values are 0-65535 (H maps to 0-360°, S and B to 0-100%)
+		return color.NewHSB64(
+			float64(c.Values[0])/65535*360,
+			float64(c.Values[1])/65535,
+			float64(c.Values[2])/65535,
+		), nil
 
 	case colorswatch.ColorSpaceCMYK:
 		// Adobe ACO CMYK values are 0-10000 (representing 0-100%)
-		cy := uint8(c.Values[0] / 100)
-		mg := uint8(c.Values[1] / 100)
-		ye := uint8(c.Values[2] / 100)
-		k := uint8(c.Values[3] / 100)
-		return color.NewCMYK(cy, mg, ye, k), nil
+		return color.NewCMYK64(
+			float64(c.Values[0])/10000,
+			float64(c.Values[1])/10000,
+			float64(c.Values[2])/10000,
+			float64(c.Values[3])/10000,
+		), nil
 
 	case colorswatch.ColorSpaceLab:
 		// Adobe ACO LAB format
 		// L: 0-10000 (0-100), a: -12800 to 12700 (-128 to 127), b: -12800 to 12700 (-128 to 127)
-		l := int8(c.Values[0] / 100)
-		a := int8((int32(c.Values[1]) - 12800) / 100)
-		b := int8((int32(c.Values[2]) - 12800) / 100)
-		return color.NewLAB(l, a, b), nil
+		// Values are relative to D50, as in Photoshop
+		return color.NewLAB64WithWhite(
+			float64(c.Values[0])/100,
+			float64(int32(c.Values[1])-12800)/100,
+			float64(int32(c.Values[2])-12800)/100,
+			color.D50,
+		), nil
 
 	case colorswatch.ColorSpaceGrayscale:
-		// Grayscale: convert to RGB
-		gray := uint8(c.Values[0] / 100)
-		return color.NewRGB(gray, gray, gray), nil
+		// Adobe ACO grayscale is 0-10000 ink coverage (0 = white, 10000 = black)
+		return color.NewGray(1 - float64(c.Values[0])/10000), nil
 
 	case colorswatch.ColorSpacePantone,
 		colorswatch.ColorSpaceFocoltone,
 		colorswatch.ColorSpaceTruematch,
 		colorswatch.ColorSpaceToyo,
 		colorswatch.ColorSpaceHKS:
-		// For spot colors, convert to RGB as approximation
-		// This is a simplification - in reality these would need proper color management
-		r := uint8(c.Values[0] >> 8)
-		g := uint8(c.Values[1] >> 8)
-		b := uint8(c.Values[2] >> 8)
-		return color.NewRGB(r, g, b), nil
+		// Spot inks from a color book, named by the swatch. The values are read as
+		// RGB for the alternate; this is a simplification - in reality these would
+		// need proper color management
+		alternate := color.NewRGB64(
+			float64(c.Values[0])/65535,
+			float64(c.Values[1])/65535,
+			float64(c.Values[2])/65535,
+		)
+		return color.NewSpot(c.Name, c.ColorSpace.String(), alternate), nil
 
 	default:
 		return nil, fmt.Errorf("unsupported color space: %v", c.ColorSpace)
@@ -213,63 +253,111 @@ func convertToAdobeSwatchColor(c color.Color, name string) (*colorswatch.Color,
 		Name: name,
 	}
 
-	// Determine the best color space based on the input color type
-	switch c.ColorSpace() {
-	case "RGB":
-		rgb := c.ToRGB()
-		adobeColor.ColorSpace = colorswatch.ColorSpaceRGB
-		// Convert 0-255 to 0-65535
-		adobeColor.Values = [4]uint16{
-			uint16(rgb.R) << 8,
-			uint16(rgb.G) << 8,
-			uint16(rgb.B) << 8,
-			0, // Alpha/unused
+	// Spot inks from a known book keep their book's color space, with RGB values
+	// as read by the importer
+	if spot, ok := color.AsSpot(c); ok {
+		if space, ok := spotColorSpace(spot.Book); ok {
+			rgb := spot.ToRGB64()
+			adobeColor.ColorSpace = space
+			adobeColor.Values = [4]uint16{
+				scaleUint16(rgb.R, 65535),
+				scaleUint16(rgb.G, 65535),
+				scaleUint16(rgb.B, 65535),
+				0, // Unused
+			}
+			return adobeColor, nil
 		}
+	}
 
+	// Determine the best color space based on the input color type
+	switch c.ColorSpace() {
 	case "HSB":
-		hsb := c.ToHSB()
+		hsb := c.ToHSB64()
 		adobeColor.ColorSpace = colorswatch.ColorSpaceHSB
 		// Convert to Adobe ACO HSB format
 		adobeColor.Values = [4]uint16{
-			uint16(hsb.H) * 182, // H: 0-360 -> 0-65520
-			uint16(hsb.S) * 655, // S: 0-100 -> 0-65500
-			uint16(hsb.B) * 655, // B: 0-100 -> 0-65500
-			0,                   // Unused
+			scaleUint16(hsb.H/360, 65535), // H: 0-360 -> 0-65535
+			scaleUint16(hsb.S, 65535),     // S: 0-1 -> 0-65535
+			scaleUint16(hsb.B, 65535),     // B: 0-1 -> 0-65535
+			0,                             // Unused
 		}
 
 	case "CMYK":
-		cmyk := c.ToCMYK()
+		cmyk := c.ToCMYK64()
 		adobeColor.ColorSpace = colorswatch.ColorSpaceCMYK
-		// Convert 0-100 to 0-10000
+		// Convert 0-1 to 0-10000
 		adobeColor.Values = [4]uint16{
-			uint16(cmyk.C) * 100,
-			uint16(cmyk.M) * 100,
-			uint16(cmyk.Y) * 100,
-			uint16(cmyk.K) * 100,
+			scaleUint16(cmyk.C, 10000),
+			scaleUint16(cmyk.M, 10000),
+			scaleUint16(cmyk.Y, 10000),
+			scaleUint16(cmyk.K, 10000),
 		}
 
 	case "LAB":
-		lab := c.ToLAB()
+		lab := c.ToLAB64().Adapt(color.D50, color.AdaptationBradford)
 		adobeColor.ColorSpace = colorswatch.ColorSpaceLab
 		// Convert to Adobe ACO LAB format
 		adobeColor.Values = [4]uint16{
-			uint16(lab.L) * 100,              // L: 0-100 -> 0-10000
-			uint16(int32(lab.A)*100 + 12800), // a: -128 to 127 -> 0 to 25500
-			uint16(int32(lab.B)*100 + 12800), // b: -128 to 127 -> 0 to 25500
-			0,                                // Unused
+			scaleUint16(lab.L/100, 10000),                                // L: 0-100 -> 0-10000
+			uint16(math.Round(clampFloat(lab.A, -128, 127)*100 + 12800)), // a: -128 to 127 -> 0 to 25500
+			uint16(math.Round(clampFloat(lab.B, -128, 127)*100 + 12800)), // b: -128 to 127 -> 0 to 25500
+			0, // Unused
+		}
+
+	case "GRAY":
+		gray := c.ToXYZ().ToGray()
+		adobeColor.ColorSpace = colorswatch.ColorSpaceGrayscale
+		adobeColor.Values = [4]uint16{
+			scaleUint16(1-gray.Y, 10000), // Gray: 0-1 -> 10000-0
+			0,                            // Unused
+			0,                            // Unused
+			0,                            // Unused
 		}
 
 	default:
 		// Default to RGB conversion
-		rgb := c.ToRGB()
+		rgb := c.ToRGB64()
 		adobeColor.ColorSpace = colorswatch.ColorSpaceRGB
+		// Convert 0-1 to 0-65535
 		adobeColor.Values = [4]uint16{
-			uint16(rgb.R) << 8,
-			uint16(rgb.G) << 8,
-			uint16(rgb.B) << 8,
-			0,
+			scaleUint16(rgb.R, 65535),
+			scaleUint16(rgb.G, 65535),
+			scaleUint16(rgb.B, 65535),
+			0, // Alpha/unused
 		}
 	}
 
 	return adobeColor, nil
 }
+
+// spotColorSpace returns the ACO color space for a spot color book, matched by
+// its brand name (e.g. "PANTONE+ Solid Coated" is Pantone).
+func spotColorSpace(book string) (colorswatch.ColorSpace, bool) {
+	book = strings.ToLower(book)
+	for _, space := range []colorswatch.ColorSpace{
+		colorswatch.ColorSpacePantone,
+		colorswatch.ColorSpaceFocoltone,
+		colorswatch.ColorSpaceTruematch,
+		colorswatch.ColorSpaceToyo,
+		colorswatch.ColorSpaceHKS,
+	} {
+		if strings.Contains(book, strings.ToLower(space.String())) {
+			return space, true
+		}
+	}
+	// Trumatch is also spelled without the e
+	if strings.Contains(book, "trumatch") {
+		return colorswatch.ColorSpaceTruematch, true
+	}
+	return 0, false
+}
+
+// scaleUint16 maps a 0-1 value onto 0-max, rounding to the nearest integer.
+func scaleUint16(v float64, max float64) uint16 {
+	return uint16(math.Round(clampFloat(v, 0, 1) * max))
+}
+
+// clampFloat restricts v to the range [min, max].
+func clampFloat(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
@@ -2,10 +2,12 @@ package colorswatch_test
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/io/colorswatch"
 	"github.com/kennyp/palette/palette"
 )
@@ -236,4 +238,193 @@ func TestLargeColorCount(t *testing.T) {
 	if output.Len() < 1000 {
 		t.Errorf("Export output seems too small for 100 colors: %d bytes", output.Len())
 	}
-}
\ No newline at end of file
+}
+
+func TestRoundTripPreservesPrecision(t *testing.T) {
+	// 16-bit ACO values should survive a round trip without being truncated to 8 bits
+	original := color.NewRGB64(0.123456, 0.654321, 0.999)
+
+	p := palette.New("Precision")
+	p.Add(original, "Precise")
+
+	var output strings.Builder
+	if err := colorswatch.NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	imported, err := colorswatch.NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	got := imported.Colors[0].Color.ToRGB64()
+	const tolerance = 1.0 / 65535
+	if math.Abs(got.R-original.R) > tolerance ||
+		math.Abs(got.G-original.G) > tolerance ||
+		math.Abs(got.B-original.B) > tolerance {
+		t.Errorf("Round trip = %v, want %v", got, original)
+	}
+}
+
+func TestLabIsD50(t *testing.T) {
+	// ACO Lab is D50-relative: values must be written and read back without adapting
+	original := color.NewLAB64WithWhite(54.29, 80.81, 69.89, color.D50)
+
+	p := palette.New("Lab")
+	p.Add(original, "Red")
+
+	var output strings.Builder
+	if err := colorswatch.NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	imported, err := colorswatch.NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	got, ok := imported.Colors[0].Color.(color.LAB64)
+	if !ok {
+		t.Fatalf("Expected LAB64, got %T", imported.Colors[0].Color)
+	}
+	if got.WhitePoint() != color.D50 {
+		t.Errorf("White point = %v, want D50", got.WhitePoint())
+	}
+
+	const tolerance = 0.01
+	if math.Abs(got.L-original.L) > tolerance ||
+		math.Abs(got.A-original.A) > tolerance ||
+		math.Abs(got.B-original.B) > tolerance {
+		t.Errorf("Round trip = %v, want %v", got, original)
+	}
+	if rgb := got.ToRGB(); rgb != color.NewRGB(255, 0, 0) {
+		t.Errorf("ToRGB() = %v, want RGB(255, 0, 0)", rgb)
+	}
+}
+
+func TestGrayscaleRoundTrip(t *testing.T) {
+	// Gray swatches must come back as gray, not RGB
+	original := color.NewGray(0.25)
+
+	p := palette.New("Gray")
+	p.Add(original, "Dark Gray")
+
+	var output strings.Builder
+	if err := colorswatch.NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	imported, err := colorswatch.NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	got, ok := imported.Colors[0].Color.(color.Gray)
+	if !ok {
+		t.Fatalf("Expected color.Gray, got %T", imported.Colors[0].Color)
+	}
+	if math.Abs(got.Y-original.Y) > 1.0/10000 {
+		t.Errorf("Round trip = %v, want %v", got, original)
+	}
+}
+
+func TestLossesReportsAlpha(t *testing.T) {
+	p := palette.New("Alpha")
+	p.Add(color.NewRGB(255, 0, 0), "Solid")
+	p.Add(color.WithAlpha(color.NewRGB(0, 0, 255), 0.25), "Glass")
+
+	losses := colorswatch.NewExporter().Losses(p)
+	if len(losses) != 1 || losses[0].Name != "Glass" || losses[0].Property != paletteio.LossAlpha {
+		t.Errorf("Losses() = %v, want alpha dropped for Glass", losses)
+	}
+}
+
+func TestSpotRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		book string
+		want string
+	}{
+		"Pantone":  {"Pantone", "Pantone"},
+		"ACB book": {"PANTONE+ Solid Coated", "Pantone"},
+		"HKS":      {"HKS K", "HKS"},
+		"Trumatch": {"TRUMATCH 4-Color Selector", "Truematch"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := palette.New("Spots")
+			p.Add(color.NewSpot("Ink 1", tt.book, color.NewRGB(228, 0, 43)), "Ink 1")
+
+			var output strings.Builder
+			if err := colorswatch.NewExporter().Export(p, &output); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			imported, err := colorswatch.NewImporter().Import(strings.NewReader(output.String()))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			nc, _ := imported.Get(0)
+			spot, ok := color.AsSpot(nc.Color)
+			if !ok {
+				t.Fatalf("Import() = %T, want color.SpotColor", nc.Color)
+			}
+			if spot.Ink != "Ink 1" || spot.Book != tt.want {
+				t.Errorf("Import() spot = %q from %q, want Ink 1 from %q", spot.Ink, spot.Book, tt.want)
+			}
+			if got := spot.ToRGB(); got != color.NewRGB(228, 0, 43) {
+				t.Errorf("Import() alternate = %v, want RGB(228, 0, 43)", got)
+			}
+		})
+	}
+
+	// Books without an ACO color space are written as their alternate
+	p := palette.New("Spots")
+	p.Add(color.NewSpot("Ink 1", "Custom Inks", color.NewCMYK(0, 100, 100, 0)), "Ink 1")
+	var output strings.Builder
+	if err := colorswatch.NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	imported, _ := colorswatch.NewImporter().Import(strings.NewReader(output.String()))
+	if nc, _ := imported.Get(0); nc.Color.ColorSpace() != "CMYK" {
+		t.Errorf("Import() = %v, want the CMYK alternate", nc.Color)
+	}
+	if losses := colorswatch.NewExporter().Losses(p); len(losses) != 1 || losses[0].Property != paletteio.LossSpot {
+		t.Errorf("Losses() = %v, want spot ink dropped for Ink 1", losses)
+	}
+}
+
+func TestImportAutoName(t *testing.T) {
+	p := palette.New("Unnamed")
+	p.Add(color.NewRGB(255, 165, 0), "Orange")
+	p.Add(color.NewRGB(0, 128, 128), "Teal")
+
+	// Version 1 files have no names
+	var output strings.Builder
+	if err := colorswatch.NewExporterV1().Export(p, &output); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	tests := map[string]struct {
+		importer paletteio.Importer
+		want     []string
+	}{
+		"Default":   {colorswatch.NewImporter(), []string{"Color 1", "Color 2"}},
+		"Auto name": {colorswatch.NewImporter().WithAutoName(color.CSSNames()), []string{"orange", "teal"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			imported, err := tt.importer.Import(strings.NewReader(output.String()))
+			if err != nil {
+				t.Fatalf("Import failed: %v", err)
+			}
+			for i, want := range tt.want {
+				if nc, _ := imported.Get(i); nc.Name != want {
+					t.Errorf("Import() color %d name = %q, want %q", i, nc.Name, want)
+				}
+			}
+		})
+	}
+}
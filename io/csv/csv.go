@@ -4,10 +4,12 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 )
 
@@ -19,6 +21,9 @@ type Importer struct {
 	HasHeader bool
 	// ColorFormat specifies the expected color format in the CSV
 	ColorFormat ColorFormat
+	// AutoName, if set, names unnamed colors after the nearest color in the set
+	// instead of their String form
+	AutoName *color.NameSet
 }
 
 // ColorFormat represents different ways colors can be represented in CSV.
@@ -31,7 +36,7 @@ const (
 	FormatRGB
 	// FormatRGBFloat expects R,G,B columns (0.0-1.0)
 	FormatRGBFloat
-	// FormatHex expects a single hex color column (#RRGGBB)
+	// FormatHex expects a single hex color column (#RGB, #RRGGBB or #RRGGBBAA)
 	FormatHex
 	// FormatCMYK expects C,M,Y,K columns (0-100)
 	FormatCMYK
@@ -39,6 +44,21 @@ const (
 	FormatHSB
 	// FormatLAB expects L,A,B columns
 	FormatLAB
+	// FormatHSL expects H,S,L columns (H: 0-360, S,L: 0-100)
+	FormatHSL
+	// FormatLCH expects L,C,H columns (L: 0-100, C: 0-230, H: 0-360)
+	FormatLCH
+	// FormatGray expects a single gray column (0-100, 0 = black)
+	FormatGray
+	// FormatOKLab expects L,A,B columns (L: 0-1, A,B: -0.5 to 0.5)
+	FormatOKLab
+	// FormatOKLCH expects L,C,H columns (L: 0-1, C: 0-0.5, H: 0-360)
+	FormatOKLCH
+	// FormatCSS expects a single CSS color column, such as "oklch(0.7 0.1 250)"
+	FormatCSS
+	// FormatText expects a single column with the text form of color.MarshalText, such
+	// as "OKLCH(0.7, 0.1, 250)", which keeps every color's type, alpha and spot ink
+	FormatText
 )
 
 // NewImporter creates a new CSV importer with default settings.
@@ -100,9 +120,20 @@ func (i *Importer) Import(r io.Reader) (*palette.Palette, error) {
 		p.Add(c, colorName)
 	}
 
+	if i.AutoName != nil {
+		p.AutoName(i.AutoName)
+	}
+
 	return p, nil
 }
 
+// WithAutoName returns a copy of the importer that names unnamed colors from set.
+func (i *Importer) WithAutoName(set *color.NameSet) paletteio.Importer {
+	named := *i
+	named.AutoName = set
+	return &named
+}
+
 // CanImport returns true if this importer can handle the given format.
 func (i *Importer) CanImport(format string) bool {
 	return format == ".csv"
@@ -113,23 +144,52 @@ func (i *Importer) SupportedFormats() []string {
 	return []string{".csv"}
 }
 
+// Losses reports spot inks, which only FormatText can store, colors whose alpha
+// cannot be stored, as only FormatText, FormatHex and FormatCSS carry alpha, and
+// colors whose values are rounded or clipped.
+func (e *Exporter) Losses(p *palette.Palette) []paletteio.Loss {
+	var losses []paletteio.Loss
+	switch e.ColorFormat {
+	case FormatText:
+	case FormatHex, FormatCSS:
+		losses = paletteio.SpotLosses(p)
+	default:
+		losses = append(paletteio.AlphaLosses(p), paletteio.SpotLosses(p)...)
+	}
+
+	importer := NewImporter()
+	return append(losses, paletteio.PrecisionLosses(p, func(c color.Color) (color.Color, error) {
+		return importer.parseColor(e.formatColor(palette.NamedColor{Name: "Color", Color: c})[1:], e.ColorFormat)
+	})...)
+}
+
 // detectFormat attempts to auto-detect the color format from a sample row.
 func (i *Importer) detectFormat(record []string) ColorFormat {
 	if len(record) == 0 {
 		return FormatRGB // Default fallback
 	}
 
+	// Sniff only the color columns, as a name such as "RGB(255, 0, 0)" looks like a color
+	_, colorData := splitName(record)
+
 	// Check for hex color (single column with # prefix)
-	if len(record) >= 1 {
-		if strings.HasPrefix(strings.TrimSpace(record[0]), "#") ||
-			strings.HasPrefix(strings.TrimSpace(record[len(record)-1]), "#") {
+	if len(colorData) >= 1 {
+		if strings.HasPrefix(strings.TrimSpace(colorData[0]), "#") ||
+			strings.HasPrefix(strings.TrimSpace(colorData[len(colorData)-1]), "#") {
 			return FormatHex
 		}
+		// The text form is checked first, as some of it, such as RGB(255, 0, 0), is also CSS
+		if isColorText(colorData[0]) || isColorText(colorData[len(colorData)-1]) {
+			return FormatText
+		}
+		if isCSSFunction(colorData[0]) || isCSSFunction(colorData[len(colorData)-1]) {
+			return FormatCSS
+		}
 	}
 
 	// Count numeric columns
 	numericCols := 0
-	for _, field := range record {
+	for _, field := range colorData {
 		if _, err := strconv.ParseFloat(strings.TrimSpace(field), 64); err == nil {
 			numericCols++
 		}
@@ -137,6 +197,8 @@ func (i *Importer) detectFormat(record []string) ColorFormat {
 
 	// Determine format based on number of numeric columns
 	switch numericCols {
+	case 1:
+		return FormatGray
 	case 3:
 		return FormatRGB // Could also be HSB or LAB, but RGB is most common
 	case 4:
@@ -146,27 +208,31 @@ func (i *Importer) detectFormat(record []string) ColorFormat {
 	}
 }
 
+// isCSSFunction reports whether a field is a CSS color function such as rgb() or oklch().
+func isCSSFunction(field string) bool {
+	if !strings.Contains(field, "(") {
+		return false
+	}
+	_, err := color.Parse(field)
+	return err == nil
+}
+
+// isColorText reports whether a field is the text form of a color, such as OKLCH(0.7, 0.1, 250).
+func isColorText(field string) bool {
+	if !strings.Contains(field, "(") {
+		return false
+	}
+	_, err := color.UnmarshalText([]byte(field))
+	return err == nil
+}
+
 // parseRow parses a single CSV row into a color name and color.
 func (i *Importer) parseRow(record []string, format ColorFormat) (string, color.Color, error) {
 	if len(record) == 0 {
 		return "", nil, fmt.Errorf("empty row")
 	}
 
-	// Try to find color name (usually first or last column that's not numeric)
-	colorName := ""
-	colorData := record
-
-	// Check if first column is non-numeric (likely a name)
-	if _, err := strconv.ParseFloat(strings.TrimSpace(record[0]), 64); err != nil {
-		colorName = strings.TrimSpace(record[0])
-		colorData = record[1:]
-	} else if len(record) > 3 {
-		// Check if last column is non-numeric (name at end)
-		if _, err := strconv.ParseFloat(strings.TrimSpace(record[len(record)-1]), 64); err != nil {
-			colorName = strings.TrimSpace(record[len(record)-1])
-			colorData = record[:len(record)-1]
-		}
-	}
+	colorName, colorData := splitName(record)
 
 	// Parse color based on format
 	c, err := i.parseColor(colorData, format)
@@ -174,13 +240,29 @@ func (i *Importer) parseRow(record []string, format ColorFormat) (string, color.
 		return "", nil, err
 	}
 
-	if colorName == "" {
+	if colorName == "" && i.AutoName == nil {
 		colorName = c.String()
 	}
 
 	return colorName, c, nil
 }
 
+// splitName separates a row's color name, usually the first or last column that's
+// not numeric, from its color columns.
+func splitName(record []string) (string, []string) {
+	// Check if first column is non-numeric (likely a name)
+	if _, err := strconv.ParseFloat(strings.TrimSpace(record[0]), 64); err != nil {
+		return strings.TrimSpace(record[0]), record[1:]
+	}
+	if len(record) > 3 {
+		// Check if last column is non-numeric (name at end)
+		if _, err := strconv.ParseFloat(strings.TrimSpace(record[len(record)-1]), 64); err != nil {
+			return strings.TrimSpace(record[len(record)-1]), record[:len(record)-1]
+		}
+	}
+	return "", record
+}
+
 // parseColor parses color data from CSV fields.
 func (i *Importer) parseColor(fields []string, format ColorFormat) (color.Color, error) {
 	switch format {
@@ -196,6 +278,20 @@ func (i *Importer) parseColor(fields []string, format ColorFormat) (color.Color,
 		return i.parseHSBColor(fields)
 	case FormatLAB:
 		return i.parseLABColor(fields)
+	case FormatHSL:
+		return i.parseHSLColor(fields)
+	case FormatLCH:
+		return i.parseLCHColor(fields)
+	case FormatGray:
+		return i.parseGrayColor(fields)
+	case FormatOKLab:
+		return i.parseOKLabColor(fields)
+	case FormatOKLCH:
+		return i.parseOKLCHColor(fields)
+	case FormatCSS:
+		return i.parseCSSColor(fields)
+	case FormatText:
+		return i.parseTextColor(fields)
 	default:
 		return nil, fmt.Errorf("unsupported color format: %v", format)
 	}
@@ -221,75 +317,69 @@ func (i *Importer) parseHexColor(fields []string) (color.Color, error) {
 		return nil, fmt.Errorf("no hex color found")
 	}
 
-	// Remove # prefix
-	hexStr = strings.TrimPrefix(hexStr, "#")
-
-	if len(hexStr) != 6 {
-		return nil, fmt.Errorf("invalid hex color format: %s", hexStr)
-	}
-
-	// Parse RGB components
-	r, err := strconv.ParseUint(hexStr[0:2], 16, 8)
+	c, err := color.Parse(hexStr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid red component: %w", err)
+		return nil, fmt.Errorf("invalid hex color: %w", err)
 	}
+	return c, nil
+}
 
-	g, err := strconv.ParseUint(hexStr[2:4], 16, 8)
-	if err != nil {
-		return nil, fmt.Errorf("invalid green component: %w", err)
+// parseCSSColor parses the first field that is a CSS color string.
+func (i *Importer) parseCSSColor(fields []string) (color.Color, error) {
+	for _, field := range fields {
+		if c, err := color.Parse(field); err == nil {
+			return c, nil
+		}
 	}
+	return nil, fmt.Errorf("no CSS color found")
+}
 
-	b, err := strconv.ParseUint(hexStr[4:6], 16, 8)
-	if err != nil {
-		return nil, fmt.Errorf("invalid blue component: %w", err)
+// parseTextColor parses the first field that is the text form of a color.
+func (i *Importer) parseTextColor(fields []string) (color.Color, error) {
+	for _, field := range fields {
+		if c, err := color.UnmarshalText([]byte(field)); err == nil {
+			return c, nil
+		}
 	}
-
-	return color.NewRGB(uint8(r), uint8(g), uint8(b)), nil
+	return nil, fmt.Errorf("no color text found")
 }
 
 // parseRGBColor parses RGB color components.
+// Whole-number components produce a color.RGB; fractional ones are kept at full precision.
 func (i *Importer) parseRGBColor(fields []string, isFloat bool) (color.Color, error) {
 	if len(fields) < 3 {
 		return nil, fmt.Errorf("insufficient RGB data: need 3 values, got %d", len(fields))
 	}
 
+	max := 255.0
 	if isFloat {
-		// Parse float values (0.0-1.0)
-		r, err := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid red component: %w", err)
-		}
-
-		g, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid green component: %w", err)
-		}
+		max = 1.0
+	}
 
-		b, err := strconv.ParseFloat(strings.TrimSpace(fields[2]), 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid blue component: %w", err)
-		}
+	r, err := parseComponent(fields[0], "red", 0, max)
+	if err != nil {
+		return nil, err
+	}
 
-		return color.NewRGBFromFloat(r, g, b), nil
-	} else {
-		// Parse integer values (0-255)
-		r, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 8)
-		if err != nil {
-			return nil, fmt.Errorf("invalid red component: %w", err)
-		}
+	g, err := parseComponent(fields[1], "green", 0, max)
+	if err != nil {
+		return nil, err
+	}
 
-		g, err := strconv.ParseUint(strings.TrimSpace(fields[1]), 10, 8)
-		if err != nil {
-			return nil, fmt.Errorf("invalid green component: %w", err)
-		}
+	b, err := parseComponent(fields[2], "blue", 0, max)
+	if err != nil {
+		return nil, err
+	}
 
-		b, err := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 8)
-		if err != nil {
-			return nil, fmt.Errorf("invalid blue component: %w", err)
-		}
+	if isFloat {
+		return color.NewRGB64(r, g, b), nil
+	}
 
+	if isWhole(r, g, b) {
 		return color.NewRGB(uint8(r), uint8(g), uint8(b)), nil
 	}
+
+	return color.NewRGB64(r/255, g/255, b/255), nil
 }
 
 // parseCMYKColor parses CMYK color components.
@@ -298,27 +388,31 @@ func (i *Importer) parseCMYKColor(fields []string) (color.Color, error) {
 		return nil, fmt.Errorf("insufficient CMYK data: need 4 values, got %d", len(fields))
 	}
 
-	c, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 8)
+	c, err := parseComponent(fields[0], "cyan", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid cyan component: %w", err)
+		return nil, err
 	}
 
-	m, err := strconv.ParseUint(strings.TrimSpace(fields[1]), 10, 8)
+	m, err := parseComponent(fields[1], "magenta", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid magenta component: %w", err)
+		return nil, err
 	}
 
-	y, err := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 8)
+	y, err := parseComponent(fields[2], "yellow", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid yellow component: %w", err)
+		return nil, err
 	}
 
-	k, err := strconv.ParseUint(strings.TrimSpace(fields[3]), 10, 8)
+	k, err := parseComponent(fields[3], "key", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid key component: %w", err)
+		return nil, err
 	}
 
-	return color.NewCMYK(uint8(c), uint8(m), uint8(y), uint8(k)), nil
+	if isWhole(c, m, y, k) {
+		return color.NewCMYK(uint8(c), uint8(m), uint8(y), uint8(k)), nil
+	}
+
+	return color.NewCMYK64(c/100, m/100, y/100, k/100), nil
 }
 
 // parseHSBColor parses HSB color components.
@@ -327,22 +421,26 @@ func (i *Importer) parseHSBColor(fields []string) (color.Color, error) {
 		return nil, fmt.Errorf("insufficient HSB data: need 3 values, got %d", len(fields))
 	}
 
-	h, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 16)
+	h, err := parseComponent(fields[0], "hue", 0, 360)
 	if err != nil {
-		return nil, fmt.Errorf("invalid hue component: %w", err)
+		return nil, err
 	}
 
-	s, err := strconv.ParseUint(strings.TrimSpace(fields[1]), 10, 8)
+	s, err := parseComponent(fields[1], "saturation", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid saturation component: %w", err)
+		return nil, err
 	}
 
-	b, err := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 8)
+	b, err := parseComponent(fields[2], "brightness", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid brightness component: %w", err)
+		return nil, err
+	}
+
+	if isWhole(h, s, b) {
+		return color.NewHSB(uint16(h), uint8(s), uint8(b)), nil
 	}
 
-	return color.NewHSB(uint16(h), uint8(s), uint8(b)), nil
+	return color.NewHSB64(h, s/100, b/100), nil
 }
 
 // parseLABColor parses LAB color components.
@@ -351,22 +449,158 @@ func (i *Importer) parseLABColor(fields []string) (color.Color, error) {
 		return nil, fmt.Errorf("insufficient LAB data: need 3 values, got %d", len(fields))
 	}
 
-	l, err := strconv.ParseInt(strings.TrimSpace(fields[0]), 10, 8)
+	l, err := parseComponent(fields[0], "L", 0, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := parseComponent(fields[1], "A", -128, 127)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := parseComponent(fields[2], "B", -128, 127)
+	if err != nil {
+		return nil, err
+	}
+
+	if isWhole(l, a, b) {
+		return color.NewLAB(int8(l), int8(a), int8(b)), nil
+	}
+
+	return color.NewLAB64(l, a, b), nil
+}
+
+// parseHSLColor parses HSL color components.
+func (i *Importer) parseHSLColor(fields []string) (color.Color, error) {
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("insufficient HSL data: need 3 values, got %d", len(fields))
+	}
+
+	h, err := parseComponent(fields[0], "hue", 0, 360)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := parseComponent(fields[1], "saturation", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid L component: %w", err)
+		return nil, err
 	}
 
-	a, err := strconv.ParseInt(strings.TrimSpace(fields[1]), 10, 8)
+	l, err := parseComponent(fields[2], "lightness", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid A component: %w", err)
+		return nil, err
 	}
 
-	b, err := strconv.ParseInt(strings.TrimSpace(fields[2]), 10, 8)
+	return color.NewHSL(h, s/100, l/100), nil
+}
+
+// parseLCHColor parses LCH color components.
+func (i *Importer) parseLCHColor(fields []string) (color.Color, error) {
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("insufficient LCH data: need 3 values, got %d", len(fields))
+	}
+
+	l, err := parseComponent(fields[0], "L", 0, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := parseComponent(fields[1], "C", 0, 230)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := parseComponent(fields[2], "H", 0, 360)
+	if err != nil {
+		return nil, err
+	}
+
+	return color.NewLCH(l, c, h), nil
+}
+
+// parseGrayColor parses a single gray component.
+func (i *Importer) parseGrayColor(fields []string) (color.Color, error) {
+	if len(fields) < 1 {
+		return nil, fmt.Errorf("insufficient gray data: need 1 value, got %d", len(fields))
+	}
+
+	g, err := parseComponent(fields[0], "gray", 0, 100)
 	if err != nil {
-		return nil, fmt.Errorf("invalid B component: %w", err)
+		return nil, err
 	}
 
-	return color.NewLAB(int8(l), int8(a), int8(b)), nil
+	return color.NewGray(g / 100), nil
+}
+
+// parseOKLabColor parses OKLab color components.
+func (i *Importer) parseOKLabColor(fields []string) (color.Color, error) {
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("insufficient OKLab data: need 3 values, got %d", len(fields))
+	}
+
+	l, err := parseComponent(fields[0], "L", 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := parseComponent(fields[1], "A", -0.5, 0.5)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := parseComponent(fields[2], "B", -0.5, 0.5)
+	if err != nil {
+		return nil, err
+	}
+
+	return color.NewOKLab(l, a, b), nil
+}
+
+// parseOKLCHColor parses OKLCH color components.
+func (i *Importer) parseOKLCHColor(fields []string) (color.Color, error) {
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("insufficient OKLCH data: need 3 values, got %d", len(fields))
+	}
+
+	l, err := parseComponent(fields[0], "L", 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := parseComponent(fields[1], "C", 0, 0.5)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := parseComponent(fields[2], "H", 0, 360)
+	if err != nil {
+		return nil, err
+	}
+
+	return color.NewOKLCH(l, c, h), nil
+}
+
+// parseComponent parses a single numeric color component and checks its range.
+func parseComponent(field, name string, min, max float64) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s component: %w", name, err)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("invalid %s component: %v out of range [%v, %v]", name, v, min, max)
+	}
+	return v, nil
+}
+
+// isWhole reports whether all values are integers.
+func isWhole(values ...float64) bool {
+	for _, v := range values {
+		if v != math.Trunc(v) {
+			return false
+		}
+	}
+	return true
 }
 
 // Exporter implements exporting palettes to CSV format.
@@ -377,6 +611,11 @@ type Exporter struct {
 	IncludeHeader bool
 	// ColorFormat specifies how colors should be formatted in the CSV
 	ColorFormat ColorFormat
+	// Precision is the number of decimal places written for numeric components, or
+	// paletteio.FullPrecision to write them unrounded. Zero writes whole numbers (three
+	// decimals for FormatRGBFloat, four for FormatOKLab and FormatOKLCH). FormatText is
+	// always written at full precision.
+	Precision int
 }
 
 // NewExporter creates a new CSV exporter with default settings.
@@ -388,6 +627,22 @@ func NewExporter() *Exporter {
 	}
 }
 
+// WithPrecision returns a copy of the exporter that writes components with the given
+// number of decimal places, or unrounded for paletteio.FullPrecision.
+func (e *Exporter) WithPrecision(places int) paletteio.Exporter {
+	rounded := *e
+	rounded.Precision = places
+	return &rounded
+}
+
+// Lossless returns a copy of the exporter that writes colors in FormatText, which
+// reads back every color, with its alpha and spot ink, as it was.
+func (e *Exporter) Lossless() paletteio.Exporter {
+	lossless := *e
+	lossless.ColorFormat = FormatText
+	return &lossless
+}
+
 // Export converts a palette to CSV format and writes it.
 func (e *Exporter) Export(p *palette.Palette, w io.Writer) error {
 	csvWriter := csv.NewWriter(w)
@@ -433,14 +688,24 @@ func (e *Exporter) getHeader() []string {
 	switch e.ColorFormat {
 	case FormatHex:
 		return []string{"Name", "Hex"}
+	case FormatCSS:
+		return []string{"Name", "CSS"}
+	case FormatText:
+		return []string{"Name", "Color"}
 	case FormatRGB, FormatRGBFloat:
 		return []string{"Name", "R", "G", "B"}
 	case FormatCMYK:
 		return []string{"Name", "C", "M", "Y", "K"}
 	case FormatHSB:
 		return []string{"Name", "H", "S", "B"}
-	case FormatLAB:
+	case FormatLAB, FormatOKLab:
 		return []string{"Name", "L", "A", "B"}
+	case FormatHSL:
+		return []string{"Name", "H", "S", "L"}
+	case FormatGray:
+		return []string{"Name", "Gray"}
+	case FormatLCH, FormatOKLCH:
+		return []string{"Name", "L", "C", "H"}
 	default:
 		return []string{"Name", "R", "G", "B"}
 	}
@@ -457,35 +722,123 @@ func (e *Exporter) formatColor(namedColor palette.NamedColor) []string {
 	case FormatHex:
 		rgb := namedColor.Color.ToRGB()
 		hex := fmt.Sprintf("#%02X%02X%02X", rgb.R, rgb.G, rgb.B)
+		if alpha, ok := namedColor.Color.(color.AlphaColor); ok {
+			hex += fmt.Sprintf("%02X", uint8(math.Round(alpha.Alpha*255)))
+		}
 		return []string{name, hex}
 
-	case FormatRGB:
-		rgb := namedColor.Color.ToRGB()
-		return []string{name, fmt.Sprintf("%d", rgb.R), fmt.Sprintf("%d", rgb.G), fmt.Sprintf("%d", rgb.B)}
+	case FormatCSS:
+		return []string{name, color.FormatCSS(namedColor.Color)}
+
+	case FormatText:
+		text, err := color.MarshalText(namedColor.Color)
+		if err != nil {
+			// Colors of types without a text form are written as XYZ
+			text, _ = color.MarshalText(namedColor.Color.ToXYZ())
+		}
+		return []string{name, string(text)}
 
 	case FormatRGBFloat:
-		rgb := namedColor.Color.ToRGB()
+		rgb := namedColor.Color.ToRGB64()
+		precision := e.Precision
+		if precision == 0 {
+			precision = 3
+		}
 		return []string{
 			name,
-			fmt.Sprintf("%.3f", float64(rgb.R)/255.0),
-			fmt.Sprintf("%.3f", float64(rgb.G)/255.0),
-			fmt.Sprintf("%.3f", float64(rgb.B)/255.0),
+			strconv.FormatFloat(rgb.R, 'f', precision, 64),
+			strconv.FormatFloat(rgb.G, 'f', precision, 64),
+			strconv.FormatFloat(rgb.B, 'f', precision, 64),
 		}
 
 	case FormatCMYK:
+		if _, whole := namedColor.Color.(color.CMYK); !whole && e.Precision != 0 {
+			cmyk := namedColor.Color.ToCMYK64()
+			return []string{name, e.formatComponent(cmyk.C*100, 0, 100), e.formatComponent(cmyk.M*100, 0, 100), e.formatComponent(cmyk.Y*100, 0, 100), e.formatComponent(cmyk.K*100, 0, 100)}
+		}
 		cmyk := namedColor.Color.ToCMYK()
 		return []string{name, fmt.Sprintf("%d", cmyk.C), fmt.Sprintf("%d", cmyk.M), fmt.Sprintf("%d", cmyk.Y), fmt.Sprintf("%d", cmyk.K)}
 
 	case FormatHSB:
+		if _, whole := namedColor.Color.(color.HSB); !whole && e.Precision != 0 {
+			hsb := namedColor.Color.ToHSB64()
+			return []string{name, e.formatComponent(hsb.H, 0, 360), e.formatComponent(hsb.S*100, 0, 100), e.formatComponent(hsb.B*100, 0, 100)}
+		}
 		hsb := namedColor.Color.ToHSB()
 		return []string{name, fmt.Sprintf("%d", hsb.H), fmt.Sprintf("%d", hsb.S), fmt.Sprintf("%d", hsb.B)}
 
 	case FormatLAB:
+		if _, whole := namedColor.Color.(color.LAB); !whole && e.Precision != 0 {
+			lab := namedColor.Color.ToLAB64()
+			return []string{name, e.formatComponent(lab.L, 0, 100), e.formatComponent(lab.A, -128, 127), e.formatComponent(lab.B, -128, 127)}
+		}
 		lab := namedColor.Color.ToLAB()
 		return []string{name, fmt.Sprintf("%d", lab.L), fmt.Sprintf("%d", lab.A), fmt.Sprintf("%d", lab.B)}
 
+	case FormatHSL:
+		hsl, ok := namedColor.Color.(color.HSL)
+		if !ok {
+			hsl = namedColor.Color.ToXYZ().ToHSL()
+		}
+		return []string{name, e.formatComponent(hsl.H, 0, 360), e.formatComponent(hsl.S*100, 0, 100), e.formatComponent(hsl.L*100, 0, 100)}
+
+	case FormatLCH:
+		lch, ok := namedColor.Color.(color.LCH)
+		if !ok || lch.WhitePoint() != color.D65 {
+			lch = namedColor.Color.ToXYZ().ToLCH()
+		}
+		return []string{name, e.formatComponent(lch.L, 0, 100), e.formatComponent(lch.C, 0, 230), e.formatComponent(lch.H, 0, 360)}
+
+	case FormatGray:
+		gray, ok := namedColor.Color.(color.Gray)
+		if !ok {
+			gray = namedColor.Color.ToXYZ().ToGray()
+		}
+		return []string{name, e.formatComponent(gray.Y*100, 0, 100)}
+
+	case FormatOKLab:
+		ok, isOKLab := namedColor.Color.(color.OKLab)
+		if !isOKLab {
+			ok = namedColor.Color.ToXYZ().ToOKLab()
+		}
+		precision := e.Precision
+		if precision == 0 {
+			precision = 4
+		}
+		return []string{
+			name,
+			strconv.FormatFloat(ok.L, 'f', precision, 64),
+			strconv.FormatFloat(ok.A, 'f', precision, 64),
+			strconv.FormatFloat(ok.B, 'f', precision, 64),
+		}
+
+	case FormatOKLCH:
+		ok, isOKLCH := namedColor.Color.(color.OKLCH)
+		if !isOKLCH {
+			ok = namedColor.Color.ToXYZ().ToOKLCH()
+		}
+		precision := e.Precision
+		if precision == 0 {
+			precision = 4
+		}
+		return []string{
+			name,
+			strconv.FormatFloat(ok.L, 'f', precision, 64),
+			strconv.FormatFloat(ok.C, 'f', precision, 64),
+			strconv.FormatFloat(ok.H, 'f', precision, 64),
+		}
+
 	default:
+		if _, whole := namedColor.Color.(color.RGB); !whole && e.Precision != 0 {
+			rgb := namedColor.Color.ToRGB64()
+			return []string{name, e.formatComponent(rgb.R*255, 0, 255), e.formatComponent(rgb.G*255, 0, 255), e.formatComponent(rgb.B*255, 0, 255)}
+		}
 		rgb := namedColor.Color.ToRGB()
 		return []string{name, fmt.Sprintf("%d", rgb.R), fmt.Sprintf("%d", rgb.G), fmt.Sprintf("%d", rgb.B)}
 	}
 }
+
+// formatComponent formats a numeric component, clamped to [min, max], using the exporter's precision.
+func (e *Exporter) formatComponent(v, min, max float64) string {
+	return strconv.FormatFloat(math.Max(min, math.Min(max, v)), 'f', e.Precision, 64)
+}
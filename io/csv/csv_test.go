@@ -2,10 +2,12 @@ package csv
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 )
 
@@ -676,4 +678,355 @@ func TestCSVExporterFormats(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestImportFractionalComponents(t *testing.T) {
+	importer := NewImporter()
+	importer.ColorFormat = FormatCMYK
+
+	csvData := `Name,C,M,Y,K
+Whole,100,0,0,0
+Fractional,12.5,0,99.25,0`
+
+	p, err := importer.Import(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	whole, _ := p.Get(0)
+	if _, ok := whole.Color.(color.CMYK); !ok {
+		t.Errorf("Import() whole values = %T, want color.CMYK", whole.Color)
+	}
+
+	fractional, _ := p.Get(1)
+	want := color.NewCMYK64(0.125, 0, 0.9925, 0)
+	if fractional.Color != want {
+		t.Errorf("Import() fractional values = %v, want %v", fractional.Color, want)
+	}
+}
+
+func TestExportPrecision(t *testing.T) {
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatLAB
+	exporter.Precision = 2
+
+	p := palette.New("Test")
+	p.Add(color.NewLAB64(53.2408, 80.0925, 67.2032), "Red")
+
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	if !strings.Contains(output.String(), "Red,53.24,80.09,67.20") {
+		t.Errorf("Export() = %q, want precise LAB values", output.String())
+	}
+}
+
+func TestOKLabFormats(t *testing.T) {
+	tests := map[string]struct {
+		format ColorFormat
+		color  color.Color
+		want   string
+	}{
+		"OKLab": {FormatOKLab, color.NewOKLab(0.628, 0.2249, 0.1258), "Name,L,A,B\nRed,0.6280,0.2249,0.1258\n"},
+		"OKLCH": {FormatOKLCH, color.NewOKLCH(0.628, 0.2577, 29.23), "Name,L,C,H\nRed,0.6280,0.2577,29.2300\n"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			exporter := NewExporter()
+			exporter.ColorFormat = tt.format
+
+			p := palette.New("Test")
+			p.Add(tt.color, "Red")
+
+			var output strings.Builder
+			if err := exporter.Export(p, &output); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			if output.String() != tt.want {
+				t.Errorf("Export() = %q, want %q", output.String(), tt.want)
+			}
+
+			importer := NewImporter()
+			importer.ColorFormat = tt.format
+			imported, err := importer.Import(strings.NewReader(output.String()))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			got, _ := imported.Get(0)
+			if got.Color != tt.color {
+				t.Errorf("Import() = %v, want %v", got.Color, tt.color)
+			}
+		})
+	}
+}
+
+func TestCylindricalFormats(t *testing.T) {
+	tests := map[string]struct {
+		format ColorFormat
+		csv    string
+		want   color.Color
+	}{
+		"HSL": {FormatHSL, "Name,H,S,L\nRed,0,100,50\n", color.NewHSL(0, 1, 0.5)},
+		"LCH": {FormatLCH, "Name,L,C,H\nRed,54,107,40\n", color.NewLCH(54, 107, 40)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			importer := NewImporter()
+			importer.ColorFormat = tt.format
+			p, err := importer.Import(strings.NewReader(tt.csv))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			got, _ := p.Get(0)
+			if got.Color != tt.want {
+				t.Errorf("Import() = %v, want %v", got.Color, tt.want)
+			}
+
+			exporter := NewExporter()
+			exporter.ColorFormat = tt.format
+			var output strings.Builder
+			if err := exporter.Export(p, &output); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			if output.String() != tt.csv {
+				t.Errorf("Export() = %q, want %q", output.String(), tt.csv)
+			}
+		})
+	}
+}
+
+func TestGrayFormat(t *testing.T) {
+	csvData := "Name,Gray\nBlack,0\nMid,50\nWhite,100\n"
+
+	importer := NewImporter()
+	p, err := importer.Import(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if format, _ := p.GetMetadata("color_format"); format != FormatGray {
+		t.Errorf("Import() detected format = %v, want FormatGray", format)
+	}
+
+	mid, _ := p.Get(1)
+	if want := color.NewGray(0.5); mid.Color != want {
+		t.Errorf("Import() = %v, want %v", mid.Color, want)
+	}
+
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatGray
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if output.String() != csvData {
+		t.Errorf("Export() = %q, want %q", output.String(), csvData)
+	}
+}
+
+func TestHexAlpha(t *testing.T) {
+	csvData := "Name,Hex\nGlass,#FF000080\nSolid,#00FF00\n"
+
+	p, err := NewImporter().Import(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	glass, _ := p.Get(0)
+	if want := color.WithAlpha(color.NewRGB(255, 0, 0), 128.0/255); glass.Color != want {
+		t.Errorf("Import() = %v, want %v", glass.Color, want)
+	}
+
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatHex
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if output.String() != csvData {
+		t.Errorf("Export() = %q, want %q", output.String(), csvData)
+	}
+	if losses := exporter.Losses(p); len(losses) != 0 {
+		t.Errorf("Losses() = %v, want none for hex", losses)
+	}
+
+	exporter.ColorFormat = FormatRGB
+	if losses := exporter.Losses(p); len(losses) != 1 || losses[0].Name != "Glass" {
+		t.Errorf("Losses() = %v, want alpha loss for Glass", losses)
+	}
+
+	p.Add(color.NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", color.NewRGB(228, 0, 43)), "185")
+	exporter.ColorFormat = FormatHex
+	if losses := exporter.Losses(p); len(losses) != 1 || losses[0].Property != paletteio.LossSpot {
+		t.Errorf("Losses() = %v, want spot ink loss for 185", losses)
+	}
+}
+
+func TestCSSFormat(t *testing.T) {
+	csvData := "Name,CSS\nSky,oklch(0.7 0.1 250)\nGlass,rgb(255 0 0 / 0.5)\nShort,#0f0\n"
+
+	p, err := NewImporter().Import(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	tests := map[int]color.Color{
+		0: color.NewOKLCH(0.7, 0.1, 250),
+		1: color.WithAlpha(color.NewRGB(255, 0, 0), 0.5),
+		2: color.NewRGB(0, 255, 0),
+	}
+	for i, want := range tests {
+		got, _ := p.Get(i)
+		if got.Color != want {
+			t.Errorf("Import() color %d = %v, want %v", i, got.Color, want)
+		}
+	}
+
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatCSS
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	want := "Name,CSS\nSky,oklch(0.7 0.1 250)\nGlass,rgb(255 0 0 / 0.5)\nShort,rgb(0 255 0)\n"
+	if output.String() != want {
+		t.Errorf("Export() = %q, want %q", output.String(), want)
+	}
+	if losses := exporter.Losses(p); len(losses) != 0 {
+		t.Errorf("Losses() = %v, want none for CSS", losses)
+	}
+}
+
+func TestImportAutoName(t *testing.T) {
+	csvData := "Name,Hex\n,#FF0000\nBrand,#FF0000\n,#F00\n"
+	autoNamed := NewImporter()
+	autoNamed.AutoName = color.CSSNames()
+
+	tests := map[string]struct {
+		importer *Importer
+		want     []string
+	}{
+		"Default":   {NewImporter(), []string{"RGB(255, 0, 0)", "Brand", "RGB(255, 0, 0)"}},
+		"Auto name": {autoNamed, []string{"red", "Brand", "red 2"}},
+		"With auto name": {
+			NewImporter().WithAutoName(color.X11Names()).(*Importer),
+			[]string{"Red", "Brand", "Red 2"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := tt.importer.Import(strings.NewReader(csvData))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			for i, want := range tt.want {
+				if nc, _ := p.Get(i); nc.Name != want {
+					t.Errorf("Import() color %d name = %q, want %q", i, nc.Name, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPrecisionLosses(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewRGB(255, 128, 0), "RGB")
+	p.Add(color.NewRGB64(0.123456, 0.654321, 0.999), "RGB64")
+	p.Add(color.NewOKLCH(0.7, 0.3, 150), "Out of sRGB")
+
+	tests := map[string]struct {
+		precision int
+		want      []string
+	}{
+		"Whole numbers":  {0, []string{"RGB64", "Out of sRGB"}},
+		"Full precision": {paletteio.FullPrecision, []string{"Out of sRGB"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			exporter := NewExporter().WithPrecision(tt.precision).(*Exporter)
+
+			var lost []string
+			for _, loss := range exporter.Losses(p) {
+				if loss.Property == paletteio.LossPrecision {
+					lost = append(lost, loss.Name)
+				}
+			}
+			if !slices.Equal(lost, tt.want) {
+				t.Errorf("Losses() = %v, want %v", lost, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportColorNames(t *testing.T) {
+	unnamed := palette.New("Test")
+	unnamed.Add(color.NewRGB(255, 0, 0), "")
+	var exported strings.Builder
+	if err := NewExporter().Export(unnamed, &exported); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	tests := map[string]string{
+		"RGB name":       "Name,R,G,B\n\"RGB(255, 0, 0)\",255,0,0\n",
+		"CSS name":       "Name,R,G,B\nrgb(255 0 0),255,0,0\n",
+		"Unnamed export": exported.String(),
+	}
+
+	for name, csvData := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewImporter().Import(strings.NewReader(csvData))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			if format, _ := p.GetMetadata("color_format"); format != FormatRGB {
+				t.Errorf("Import() detected format = %v, want %v", format, FormatRGB)
+			}
+			if got, _ := p.Get(0); got.Color != color.NewRGB(255, 0, 0) {
+				t.Errorf("Import() color = %v, want RGB(255, 0, 0)", got.Color)
+			}
+		})
+	}
+}
+
+func TestLosslessExport(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewRGB64(0.123456, 0.654321, 0.999), "RGB64")
+	p.Add(color.NewCMYK64(0.1, 0.25, 0.333, 0.05), "CMYK64")
+	p.Add(color.NewOKLCH(0.7, 0.3, 150), "Out of sRGB")
+	p.Add(color.WithAlpha(color.NewOKLab(0.5, 0.1, -0.1), 0.25), "Glass")
+	p.Add(color.DisplayP3.New(0, 1, 0.2), "P3")
+	p.Add(color.NewSpot("Ink", "Book", color.NewCMYK64(0, 0.9, 0.8, 0.01)), "Spot")
+	p.Add(color.NewOKLCH(0.5, 0.1, 30), "")
+
+	exporter := NewExporter().Lossless()
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if format, _ := imported.GetMetadata("color_format"); format != FormatText {
+		t.Errorf("Import() detected format = %v, want %v", format, FormatText)
+	}
+
+	for i, want := range p.Colors {
+		got, _ := imported.Get(i)
+		if got.Color != want.Color {
+			t.Errorf("Round trip %s = %v, want %v", want.Name, got.Color, want.Color)
+		}
+	}
+
+	if losses := exporter.(*Exporter).Losses(p); len(losses) != 0 {
+		t.Errorf("Losses() = %v, want none", losses)
+	}
+}
@@ -0,0 +1,61 @@
+// Package imagepalette provides importers for the stock palettes in Go's
+// image/color/palette package.
+package imagepalette
+
+import (
+	"fmt"
+	imagecolor "image/color"
+	stdpalette "image/color/palette"
+	"io"
+	"strings"
+
+	"github.com/kennyp/palette/palette"
+)
+
+// Importer implements importing one of the stock image/color/palette palettes.
+// The palettes are built in, so the reader passed to Import is not read.
+type Importer struct {
+	name    string
+	colors  imagecolor.Palette
+	formats []string
+}
+
+// NewPlan9Importer creates an importer for the 256-color Plan 9 palette.
+func NewPlan9Importer() *Importer {
+	return &Importer{name: "Plan 9", colors: stdpalette.Plan9, formats: []string{"plan9"}}
+}
+
+// NewWebSafeImporter creates an importer for the 216-color web safe palette.
+func NewWebSafeImporter() *Importer {
+	return &Importer{name: "Web Safe", colors: stdpalette.WebSafe, formats: []string{"websafe"}}
+}
+
+// Import returns the stock palette, naming each color by its hex value.
+func (i *Importer) Import(_ io.Reader) (*palette.Palette, error) {
+	p := palette.FromImagePalette(i.name, i.colors)
+	for idx := range p.Colors {
+		rgb := p.Colors[idx].Color.ToRGB()
+		p.Colors[idx].Name = fmt.Sprintf("#%02X%02X%02X", rgb.R, rgb.G, rgb.B)
+	}
+
+	p.SetMetadata("format", "image/color/palette")
+
+	return p, nil
+}
+
+// CanImport returns true if this importer can handle the given format. A leading
+// dot is ignored, as the CLI adds one to every format name.
+func (i *Importer) CanImport(format string) bool {
+	format = strings.TrimPrefix(format, ".")
+	for _, f := range i.formats {
+		if strings.EqualFold(format, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportedFormats returns the list of supported formats.
+func (i *Importer) SupportedFormats() []string {
+	return i.formats
+}
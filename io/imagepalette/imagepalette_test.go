@@ -0,0 +1,46 @@
+package imagepalette_test
+
+import (
+	"testing"
+
+	paletteio "github.com/kennyp/palette/io"
+	"github.com/kennyp/palette/io/imagepalette"
+)
+
+func TestImport(t *testing.T) {
+	tests := map[string]struct {
+		importer  *imagepalette.Importer
+		format    string
+		wantName  string
+		wantLen   int
+		wantFirst string
+		wantLast  string
+	}{
+		"Plan9":   {imagepalette.NewPlan9Importer(), "plan9", "Plan 9", 256, "#000000", "#FFFFFF"},
+		"WebSafe": {imagepalette.NewWebSafeImporter(), "websafe", "Web Safe", 216, "#000000", "#FFFFFF"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := tt.importer.Import(nil)
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			if p.Name != tt.wantName || p.Len() != tt.wantLen {
+				t.Fatalf("Import() = %q with %d colors, want %q with %d", p.Name, p.Len(), tt.wantName, tt.wantLen)
+			}
+			if first, last := p.Colors[0].Name, p.Colors[p.Len()-1].Name; first != tt.wantFirst || last != tt.wantLast {
+				t.Errorf("Import() names = %s..%s, want %s..%s", first, last, tt.wantFirst, tt.wantLast)
+			}
+
+			registry := paletteio.NewRegistry()
+			registry.RegisterImporter(tt.importer)
+			if _, err := registry.Import(nil, tt.format); err != nil {
+				t.Errorf("Registry.Import(%q) error = %v", tt.format, err)
+			}
+			if !tt.importer.CanImport("." + tt.format) {
+				t.Errorf("CanImport(%q) = false, want true", "."+tt.format)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/kennyp/palette/color"
 	"github.com/kennyp/palette/palette"
 )
 
@@ -35,6 +36,59 @@ type Exporter interface {
 	SupportedFormats() []string
 }
 
+// Loss describes a property of a color that an exporter's format cannot store.
+type Loss struct {
+	// Index is the position of the color in the palette
+	Index int
+	// Name is the name of the color
+	Name string
+	// Property is what was dropped, such as LossAlpha
+	Property string
+}
+
+// Loss properties.
+const (
+	LossAlpha     = "alpha"          // A color's opacity is dropped
+	LossSpot      = "spot ink"       // A spot color is written as its process alternate
+	LossPrecision = "full precision" // A color's values are rounded or clipped, so it changes on import
+)
+
+// FullPrecision is the precision of exporters that write values without rounding.
+const FullPrecision = -1
+
+func (l Loss) String() string {
+	return fmt.Sprintf("color %d (%s): %s dropped", l.Index, l.Name, l.Property)
+}
+
+// LossReporter is implemented by exporters whose format cannot store every
+// property of a palette.
+type LossReporter interface {
+	// Losses returns the properties that exporting p would discard.
+	Losses(p *palette.Palette) []Loss
+}
+
+// AutoNamer is implemented by importers that can give unnamed colors readable
+// names instead of placeholders such as "Color 1".
+type AutoNamer interface {
+	// WithAutoName returns a copy of the importer that names unnamed colors after
+	// the nearest color in set.
+	WithAutoName(set *color.NameSet) Importer
+}
+
+// PrecisionSetter is implemented by exporters that can round the values they write.
+type PrecisionSetter interface {
+	// WithPrecision returns a copy of the exporter that writes values with the given
+	// number of decimal places, or without rounding for FullPrecision.
+	WithPrecision(places int) Exporter
+}
+
+// LosslessExporter is implemented by exporters that can write every color exactly.
+type LosslessExporter interface {
+	// Lossless returns a copy of the exporter that writes every color so that it is
+	// read back unchanged, such as in its own color space without rounding.
+	Lossless() Exporter
+}
+
 // Registry manages importers and exporters for different formats.
 type Registry struct {
 	importers []Importer
@@ -85,6 +139,26 @@ func (r *Registry) FindExporter(format string) (Exporter, error) {
 	return nil, fmt.Errorf("no exporter found for format: %s", format)
 }
 
+// FindExporterWithPrecision finds an exporter like FindExporter that writes values with
+// the given number of decimal places. FullPrecision asks for lossless output: exporters
+// that implement LosslessExporter write every color exactly, and others write their
+// values without rounding. Exporters that implement neither are returned as they are.
+func (r *Registry) FindExporterWithPrecision(format string, places int) (Exporter, error) {
+	exporter, err := r.FindExporter(format)
+	if err != nil {
+		return nil, err
+	}
+
+	if lossless, ok := exporter.(LosslessExporter); ok && places == FullPrecision {
+		return lossless.Lossless(), nil
+	}
+	if setter, ok := exporter.(PrecisionSetter); ok {
+		exporter = setter.WithPrecision(places)
+	}
+
+	return exporter, nil
+}
+
 // Import imports a palette using the appropriate importer for the given format.
 func (r *Registry) Import(reader io.Reader, format string) (*palette.Palette, error) {
 	importer, err := r.FindImporter(format)
@@ -95,6 +169,28 @@ func (r *Registry) Import(reader io.Reader, format string) (*palette.Palette, er
 	return importer.Import(reader)
 }
 
+// ImportAutoNamed imports a palette like Import, naming unnamed colors after the nearest
+// color in set. Importers that implement AutoNamer name them in place of their
+// placeholder names; for other formats, colors without any name are named.
+func (r *Registry) ImportAutoNamed(reader io.Reader, format string, set *color.NameSet) (*palette.Palette, error) {
+	importer, err := r.FindImporter(format)
+	if err != nil {
+		return nil, err
+	}
+
+	if namer, ok := importer.(AutoNamer); ok {
+		importer = namer.WithAutoName(set)
+	}
+
+	p, err := importer.Import(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	p.AutoName(set)
+	return p, nil
+}
+
 // Export exports a palette using the appropriate exporter for the given format.
 func (r *Registry) Export(p *palette.Palette, writer io.Writer, format string) error {
 	exporter, err := r.FindExporter(format)
@@ -105,6 +201,21 @@ func (r *Registry) Export(p *palette.Palette, writer io.Writer, format string) e
 	return exporter.Export(p, writer)
 }
 
+// Losses returns the properties that exporting p in the given format would discard.
+// Exporters that do not implement LossReporter are assumed to be lossless.
+func (r *Registry) Losses(p *palette.Palette, format string) ([]Loss, error) {
+	exporter, err := r.FindExporter(format)
+	if err != nil {
+		return nil, err
+	}
+
+	if reporter, ok := exporter.(LossReporter); ok {
+		return reporter.Losses(p), nil
+	}
+
+	return nil, nil
+}
+
 // ImportFromFile imports a palette from a file, detecting the format from the file extension.
 func (r *Registry) ImportFromFile(filename string, reader io.Reader) (*palette.Palette, error) {
 	ext := filepath.Ext(filename)
@@ -206,11 +317,22 @@ func Import(reader io.Reader, format string) (*palette.Palette, error) {
 	return DefaultRegistry.Import(reader, format)
 }
 
+// ImportAutoNamed imports a palette using the default registry, naming unnamed colors
+// after the nearest color in set.
+func ImportAutoNamed(reader io.Reader, format string, set *color.NameSet) (*palette.Palette, error) {
+	return DefaultRegistry.ImportAutoNamed(reader, format, set)
+}
+
 // Export exports a palette using the default registry.
 func Export(p *palette.Palette, writer io.Writer, format string) error {
 	return DefaultRegistry.Export(p, writer, format)
 }
 
+// Losses returns the properties that exporting p would discard, using the default registry.
+func Losses(p *palette.Palette, format string) ([]Loss, error) {
+	return DefaultRegistry.Losses(p, format)
+}
+
 // ImportFromFile imports a palette from a file using the default registry.
 func ImportFromFile(filename string, reader io.Reader) (*palette.Palette, error) {
 	return DefaultRegistry.ImportFromFile(filename, reader)
@@ -221,6 +343,44 @@ func ExportToFile(p *palette.Palette, filename string, writer io.Writer) error {
 	return DefaultRegistry.ExportToFile(p, filename, writer)
 }
 
+// AlphaLosses returns a LossAlpha for every translucent color in p. Exporters for
+// formats without an alpha channel use it to implement LossReporter.
+func AlphaLosses(p *palette.Palette) []Loss {
+	var losses []Loss
+	for i, c := range p.Colors {
+		if color.AlphaOf(c.Color) < 1 {
+			losses = append(losses, Loss{Index: i, Name: c.Name, Property: LossAlpha})
+		}
+	}
+	return losses
+}
+
+// SpotLosses returns a LossSpot for every spot color in p. Exporters for formats
+// without spot colors use it to implement LossReporter.
+func SpotLosses(p *palette.Palette) []Loss {
+	var losses []Loss
+	for i, c := range p.Colors {
+		if _, ok := color.AsSpot(c.Color); ok {
+			losses = append(losses, Loss{Index: i, Name: c.Name, Property: LossSpot})
+		}
+	}
+	return losses
+}
+
+// PrecisionLosses returns a LossPrecision for every color in p that roundTrip, which
+// writes a color and reads it back, changes. Alpha is not compared; see AlphaLosses.
+// Exporters that round or clip values use it to implement LossReporter.
+func PrecisionLosses(p *palette.Palette, roundTrip func(color.Color) (color.Color, error)) []Loss {
+	var losses []Loss
+	for i, c := range p.Colors {
+		back, err := roundTrip(c.Color)
+		if err != nil || color.DeltaE(color.Opaque(c.Color), color.Opaque(back), color.DeltaECIE76) > 1e-6 {
+			losses = append(losses, Loss{Index: i, Name: c.Name, Property: LossPrecision})
+		}
+	}
+	return losses
+}
+
 // Helper functions
 
 // normalizeFormat normalizes a format string (file extension or MIME type).
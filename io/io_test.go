@@ -202,6 +202,32 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestImportAutoNamed(t *testing.T) {
+	registry := NewRegistry()
+	testPalette := palette.New("Test")
+	testPalette.Add(color.NewRGB(255, 0, 0), "")
+	testPalette.Add(color.NewRGB(0, 0, 255), "Brand Blue")
+	registry.RegisterImporter(&mockImporter{
+		formats: []string{".test"},
+		palette: testPalette,
+	})
+
+	p, err := registry.ImportAutoNamed(strings.NewReader("test data"), ".test", color.CSSNames())
+	if err != nil {
+		t.Fatalf("ImportAutoNamed() error = %v", err)
+	}
+
+	for i, want := range []string{"red", "Brand Blue"} {
+		if nc, _ := p.Get(i); nc.Name != want {
+			t.Errorf("ImportAutoNamed() color %d name = %q, want %q", i, nc.Name, want)
+		}
+	}
+
+	if _, err := registry.ImportAutoNamed(strings.NewReader(""), ".missing", color.CSSNames()); err == nil {
+		t.Error("ImportAutoNamed() should error for non-existent format")
+	}
+}
+
 func TestExport(t *testing.T) {
 	registry := NewRegistry()
 	exporter := &mockExporter{
@@ -471,4 +497,135 @@ func BenchmarkNormalizeFormat(b *testing.B) {
 	for i := range b.N {
 		_ = normalizeFormat(formats[i%len(formats)])
 	}
-}
\ No newline at end of file
+}
+
+// Mock exporter that cannot store alpha
+type lossyExporter struct {
+	mockExporter
+}
+
+func (m *lossyExporter) Losses(p *palette.Palette) []Loss {
+	return AlphaLosses(p)
+}
+
+func TestLosses(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterExporter(&mockExporter{formats: []string{".json"}})
+	registry.RegisterExporter(&lossyExporter{mockExporter{formats: []string{".aco"}}})
+
+	p := palette.New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Opaque")
+	p.Add(color.WithAlpha(color.NewRGB(0, 0, 255), 0.5), "Translucent")
+	p.Add(color.WithAlpha(color.NewRGB(0, 255, 0), 1), "Explicitly opaque")
+
+	tests := map[string]struct {
+		format  string
+		want    []Loss
+		wantErr bool
+	}{
+		"Lossless exporter": {".json", nil, false},
+		"Lossy exporter":    {".aco", []Loss{{Index: 1, Name: "Translucent", Property: LossAlpha}}, false},
+		"Unknown format":    {".xyz", nil, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := registry.Losses(p, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Losses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Losses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLossString(t *testing.T) {
+	loss := Loss{Index: 2, Name: "Glass", Property: LossAlpha}
+	if got, want := loss.String(), "color 2 (Glass): alpha dropped"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
+
+func TestSpotLosses(t *testing.T) {
+	p := palette.New("Spots")
+	p.Add(color.NewRGB(255, 0, 0), "Process")
+	p.Add(color.NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", color.NewRGB(228, 0, 43)), "185")
+
+	want := []Loss{{Index: 1, Name: "185", Property: LossSpot}}
+	if got := SpotLosses(p); !slices.Equal(got, want) {
+		t.Errorf("SpotLosses() = %v, want %v", got, want)
+	}
+}
+
+// Mock exporter that rounds to its precision
+type roundingExporter struct {
+	mockExporter
+	places int
+}
+
+func (m *roundingExporter) WithPrecision(places int) Exporter {
+	return &roundingExporter{m.mockExporter, places}
+}
+
+// Mock exporter that can also write colors exactly
+type losslessExporter struct {
+	roundingExporter
+}
+
+func (m *losslessExporter) Lossless() Exporter {
+	return &mockExporter{formats: m.formats, output: "lossless"}
+}
+
+func TestFindExporterWithPrecision(t *testing.T) {
+	registry := NewRegistry()
+	registry.RegisterExporter(&mockExporter{formats: []string{".aco"}})
+	registry.RegisterExporter(&roundingExporter{mockExporter{formats: []string{".json"}}, 0})
+	registry.RegisterExporter(&losslessExporter{roundingExporter{mockExporter{formats: []string{".csv"}}, 0}})
+
+	exporter, err := registry.FindExporterWithPrecision(".json", 2)
+	if err != nil {
+		t.Fatalf("FindExporterWithPrecision() error = %v", err)
+	}
+	if rounding, ok := exporter.(*roundingExporter); !ok || rounding.places != 2 {
+		t.Errorf("FindExporterWithPrecision() = %v, want a rounding exporter with 2 places", exporter)
+	}
+
+	exporter, err = registry.FindExporterWithPrecision(".json", FullPrecision)
+	if rounding, ok := exporter.(*roundingExporter); err != nil || !ok || rounding.places != FullPrecision {
+		t.Errorf("FindExporterWithPrecision() = %v, %v, want a rounding exporter at full precision", exporter, err)
+	}
+
+	exporter, err = registry.FindExporterWithPrecision(".csv", FullPrecision)
+	if mock, ok := exporter.(*mockExporter); err != nil || !ok || mock.output != "lossless" {
+		t.Errorf("FindExporterWithPrecision() = %v, %v, want the lossless exporter", exporter, err)
+	}
+	exporter, err = registry.FindExporterWithPrecision(".csv", 2)
+	if rounding, ok := exporter.(*roundingExporter); err != nil || !ok || rounding.places != 2 {
+		t.Errorf("FindExporterWithPrecision() = %v, %v, want a rounding exporter with 2 places", exporter, err)
+	}
+
+	if _, err := registry.FindExporterWithPrecision(".aco", 2); err != nil {
+		t.Errorf("FindExporterWithPrecision() error = %v for an exporter without precision", err)
+	}
+	if _, err := registry.FindExporterWithPrecision(".xyz", 2); err == nil {
+		t.Error("FindExporterWithPrecision() should fail for an unknown format")
+	}
+}
+
+func TestPrecisionLosses(t *testing.T) {
+	p := palette.New("Precise")
+	p.Add(color.NewRGB(255, 0, 0), "Whole")
+	p.Add(color.NewRGB64(0.123456, 0.5, 1), "Fractional")
+
+	// Write colors as 8-bit RGB
+	roundTrip := func(c color.Color) (color.Color, error) {
+		return c.ToRGB(), nil
+	}
+
+	want := []Loss{{Index: 1, Name: "Fractional", Property: LossPrecision}}
+	if got := PrecisionLosses(p, roundTrip); !slices.Equal(got, want) {
+		t.Errorf("PrecisionLosses() = %v, want %v", got, want)
+	}
+}
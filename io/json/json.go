@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"strings"
 
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 )
 
@@ -13,6 +16,9 @@ import (
 type Importer struct {
 	// StrictMode determines if unknown fields should cause an error
 	StrictMode bool
+	// AutoName, if set, names unnamed colors after the nearest color in the set
+	// instead of "Color 1", "Color 2" and so on
+	AutoName *color.NameSet
 }
 
 // NewImporter creates a new JSON importer.
@@ -22,6 +28,13 @@ func NewImporter() *Importer {
 	}
 }
 
+// WithAutoName returns a copy of the importer that names unnamed colors from set.
+func (i *Importer) WithAutoName(set *color.NameSet) paletteio.Importer {
+	named := *i
+	named.AutoName = set
+	return &named
+}
+
 // Import reads a JSON file and converts it to a palette.
 func (i *Importer) Import(r io.Reader) (*palette.Palette, error) {
 	data, err := io.ReadAll(r)
@@ -66,54 +79,119 @@ func (i *Importer) SupportedFormats() []string {
 	return []string{".json"}
 }
 
+// Losses reports colors whose values are rounded or clipped, and colors whose alpha
+// cannot be stored because neither primary, RGB, hex nor CSS values are written.
+func (e *Exporter) Losses(p *palette.Palette) []paletteio.Loss {
+	var losses []paletteio.Loss
+	if e.ColorFormat&(FormatPrimary|FormatRGB|FormatHex|FormatCSS) == 0 {
+		losses = paletteio.AlphaLosses(p)
+	}
+
+	importer := NewImporter()
+	return append(losses, paletteio.PrecisionLosses(p, func(c color.Color) (color.Color, error) {
+		return importer.convertColorJSON(e.convertColorToJSON(palette.NamedColor{Color: c}))
+	})...)
+}
+
 // PaletteJSON represents the JSON structure for a complete palette.
 type PaletteJSON struct {
 	Name        string      `json:"name"`
 	Description string      `json:"description,omitempty"`
 	Colors      []ColorJSON `json:"colors"`
-	Metadata    any `json:"metadata,omitempty"`
+	Metadata    any         `json:"metadata,omitempty"`
 }
 
 // ColorJSON represents the JSON structure for a color.
 type ColorJSON struct {
-	Name       string                 `json:"name,omitempty"`
-	ColorSpace string                 `json:"color_space,omitempty"`
-	RGB        *RGBValues             `json:"rgb,omitempty"`
-	CMYK       *CMYKValues            `json:"cmyk,omitempty"`
-	HSB        *HSBValues             `json:"hsb,omitempty"`
-	LAB        *LABValues             `json:"lab,omitempty"`
-	Hex        string                 `json:"hex,omitempty"`
+	Name       string         `json:"name,omitempty"`
+	ColorSpace string         `json:"color_space,omitempty"`
+	RGB        *RGBValues     `json:"rgb,omitempty"`
+	RGBA       *RGBAValues    `json:"rgba,omitempty"`
+	CMYK       *CMYKValues    `json:"cmyk,omitempty"`
+	HSB        *HSBValues     `json:"hsb,omitempty"`
+	LAB        *LABValues     `json:"lab,omitempty"`
+	HSL        *HSLValues     `json:"hsl,omitempty"`
+	LCH        *LCHValues     `json:"lch,omitempty"`
+	Gray       *float64       `json:"gray,omitempty"`
+	OKLab      *OKLabValues   `json:"oklab,omitempty"`
+	OKLCH      *OKLCHValues   `json:"oklch,omitempty"`
+	Hex        string         `json:"hex,omitempty"`
+	CSS        string         `json:"css,omitempty"`
+	Spot       *SpotValues    `json:"spot,omitempty"`
+	Alpha      *float64       `json:"alpha,omitempty"`
 	Values     any            `json:"values,omitempty"`
 	Metadata   map[string]any `json:"metadata,omitempty"`
 }
 
-// RGBValues represents RGB color values.
+// RGBValues represents RGB color values (0-255).
 type RGBValues struct {
-	R uint8 `json:"r"`
-	G uint8 `json:"g"`
-	B uint8 `json:"b"`
+	R float64 `json:"r"`
+	G float64 `json:"g"`
+	B float64 `json:"b"`
+}
+
+// RGBAValues represents RGB color values (0-255) with an alpha value (0-1).
+type RGBAValues struct {
+	R float64 `json:"r"`
+	G float64 `json:"g"`
+	B float64 `json:"b"`
+	A float64 `json:"a"`
 }
 
-// CMYKValues represents CMYK color values.
+// CMYKValues represents CMYK color values (0-100).
 type CMYKValues struct {
-	C uint8 `json:"c"`
-	M uint8 `json:"m"`
-	Y uint8 `json:"y"`
-	K uint8 `json:"k"`
+	C float64 `json:"c"`
+	M float64 `json:"m"`
+	Y float64 `json:"y"`
+	K float64 `json:"k"`
 }
 
-// HSBValues represents HSB color values.
+// HSBValues represents HSB color values (H: 0-360, S,B: 0-100).
 type HSBValues struct {
-	H uint16 `json:"h"`
-	S uint8  `json:"s"`
-	B uint8  `json:"b"`
+	H float64 `json:"h"`
+	S float64 `json:"s"`
+	B float64 `json:"b"`
 }
 
-// LABValues represents LAB color values.
+// LABValues represents LAB color values (L: 0-100, A,B: -128 to 127).
 type LABValues struct {
-	L int8 `json:"l"`
-	A int8 `json:"a"`
-	B int8 `json:"b"`
+	L float64 `json:"l"`
+	A float64 `json:"a"`
+	B float64 `json:"b"`
+}
+
+// HSLValues represents HSL color values (H: 0-360, S,L: 0-100).
+type HSLValues struct {
+	H float64 `json:"h"`
+	S float64 `json:"s"`
+	L float64 `json:"l"`
+}
+
+// LCHValues represents CIE LCh color values (L: 0-100, C: 0-230, H: 0-360).
+type LCHValues struct {
+	L float64 `json:"l"`
+	C float64 `json:"c"`
+	H float64 `json:"h"`
+}
+
+// OKLabValues represents OKLab color values (L: 0-1, A,B: about -0.4 to 0.4).
+type OKLabValues struct {
+	L float64 `json:"l"`
+	A float64 `json:"a"`
+	B float64 `json:"b"`
+}
+
+// OKLCHValues represents OKLCH color values (L: 0-1, C: 0-0.4, H: 0-360).
+type OKLCHValues struct {
+	L float64 `json:"l"`
+	C float64 `json:"c"`
+	H float64 `json:"h"`
+}
+
+// SpotValues identifies a spot ink; the color's other values are its alternate.
+type SpotValues struct {
+	Ink  string `json:"ink"`
+	Book string `json:"book,omitempty"`
 }
 
 // convertFromPaletteJSON converts a PaletteJSON to a palette.
@@ -135,11 +213,15 @@ func (i *Importer) convertFromPaletteJSON(data PaletteJSON) (*palette.Palette, e
 		}
 
 		colorName := colorData.Name
-		if colorName == "" {
+		if colorName == "" && i.AutoName == nil {
 			colorName = fmt.Sprintf("Color %d", idx+1)
 		}
 
-		p.Add(c, colorName)
+		p.Colors = append(p.Colors, newNamedColor(colorName, c, colorData.Metadata))
+	}
+
+	if i.AutoName != nil {
+		p.AutoName(i.AutoName)
 	}
 
 	return p, nil
@@ -157,16 +239,29 @@ func (i *Importer) convertFromColorArray(colors []ColorJSON) (*palette.Palette,
 		}
 
 		colorName := colorData.Name
-		if colorName == "" {
+		if colorName == "" && i.AutoName == nil {
 			colorName = fmt.Sprintf("Color %d", idx+1)
 		}
 
-		p.Add(c, colorName)
+		p.Colors = append(p.Colors, newNamedColor(colorName, c, colorData.Metadata))
+	}
+
+	if i.AutoName != nil {
+		p.AutoName(i.AutoName)
 	}
 
 	return p, nil
 }
 
+// newNamedColor creates a named color with the metadata of a ColorJSON.
+func newNamedColor(name string, c color.Color, metadata map[string]any) palette.NamedColor {
+	nc := palette.NamedColor{Name: name, Color: c}
+	for key, value := range metadata {
+		nc.SetMetadata(key, value)
+	}
+	return nc
+}
+
 // convertFromGenericJSON attempts to parse generic JSON color data.
 func (i *Importer) convertFromGenericJSON(data map[string]any) (*palette.Palette, error) {
 	p := palette.New("JSON Import")
@@ -200,7 +295,7 @@ func (i *Importer) convertFromGenericJSON(data map[string]any) (*palette.Palette
 				break
 			}
 		}
-		
+
 		if !hasNestedStructure {
 			return nil, fmt.Errorf("no recognizable color data found in JSON")
 		}
@@ -211,25 +306,85 @@ func (i *Importer) convertFromGenericJSON(data map[string]any) (*palette.Palette
 
 // convertColorJSON converts a ColorJSON to a color.Color.
 func (i *Importer) convertColorJSON(data ColorJSON) (color.Color, error) {
+	// Spot inks wrap the alternate given by the other values
+	if data.Spot != nil {
+		spot := *data.Spot
+		data.Spot = nil
+
+		c, err := i.convertColorJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		if alpha, ok := c.(color.AlphaColor); ok {
+			return color.WithAlpha(color.NewSpot(spot.Ink, spot.Book, c), alpha.Alpha), nil
+		}
+		return color.NewSpot(spot.Ink, spot.Book, c), nil
+	}
+
+	// Alpha applies to the color given by the other values
+	if data.Alpha != nil {
+		alpha := *data.Alpha
+		data.Alpha = nil
+
+		c, err := i.convertColorJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		return color.WithAlpha(color.Opaque(c), alpha), nil
+	}
+
+	// Values in the color's own space are exact, so they win over derived ones
+	if c, ok, err := i.convertOwnSpace(data); ok {
+		return c, err
+	}
+
 	// Try each color space in order of preference
 	if data.RGB != nil {
-		return color.NewRGB(data.RGB.R, data.RGB.G, data.RGB.B), nil
+		return newRGB(data.RGB.R, data.RGB.G, data.RGB.B), nil
+	}
+
+	if data.RGBA != nil {
+		return color.WithAlpha(newRGB(data.RGBA.R, data.RGBA.G, data.RGBA.B), data.RGBA.A), nil
 	}
 
 	if data.Hex != "" {
 		return i.parseHexColor(data.Hex)
 	}
 
+	if data.CSS != "" {
+		return color.Parse(data.CSS)
+	}
+
 	if data.CMYK != nil {
-		return color.NewCMYK(data.CMYK.C, data.CMYK.M, data.CMYK.Y, data.CMYK.K), nil
+		return newCMYK(data.CMYK.C, data.CMYK.M, data.CMYK.Y, data.CMYK.K), nil
 	}
 
 	if data.HSB != nil {
-		return color.NewHSB(data.HSB.H, data.HSB.S, data.HSB.B), nil
+		return newHSB(data.HSB.H, data.HSB.S, data.HSB.B), nil
 	}
 
 	if data.LAB != nil {
-		return color.NewLAB(data.LAB.L, data.LAB.A, data.LAB.B), nil
+		return newLAB(data.LAB.L, data.LAB.A, data.LAB.B), nil
+	}
+
+	if data.HSL != nil {
+		return color.NewHSL(data.HSL.H, data.HSL.S/100, data.HSL.L/100), nil
+	}
+
+	if data.LCH != nil {
+		return color.NewLCH(data.LCH.L, data.LCH.C, data.LCH.H), nil
+	}
+
+	if data.Gray != nil {
+		return color.NewGray(*data.Gray / 100), nil
+	}
+
+	if data.OKLab != nil {
+		return color.NewOKLab(data.OKLab.L, data.OKLab.A, data.OKLab.B), nil
+	}
+
+	if data.OKLCH != nil {
+		return color.NewOKLCH(data.OKLCH.L, data.OKLCH.C, data.OKLCH.H), nil
 	}
 
 	// Try to parse from generic values field
@@ -240,27 +395,68 @@ func (i *Importer) convertColorJSON(data ColorJSON) (color.Color, error) {
 	return nil, fmt.Errorf("no valid color data found")
 }
 
-// parseHexColor parses a hex color string.
+// convertOwnSpace converts the values of the color's own space, as named by its
+// color_space, if they are present.
+func (i *Importer) convertOwnSpace(data ColorJSON) (color.Color, bool, error) {
+	switch strings.ToUpper(data.ColorSpace) {
+	case "", "RGB":
+		// RGB values are preferred anyway
+	case "CMYK":
+		if data.CMYK != nil {
+			return newCMYK(data.CMYK.C, data.CMYK.M, data.CMYK.Y, data.CMYK.K), true, nil
+		}
+	case "HSB":
+		if data.HSB != nil {
+			return newHSB(data.HSB.H, data.HSB.S, data.HSB.B), true, nil
+		}
+	case "LAB":
+		if data.LAB != nil {
+			return newLAB(data.LAB.L, data.LAB.A, data.LAB.B), true, nil
+		}
+	case "HSL":
+		if data.HSL != nil {
+			return color.NewHSL(data.HSL.H, data.HSL.S/100, data.HSL.L/100), true, nil
+		}
+	case "LCH":
+		if data.LCH != nil {
+			return color.NewLCH(data.LCH.L, data.LCH.C, data.LCH.H), true, nil
+		}
+	case "GRAY":
+		if data.Gray != nil {
+			return color.NewGray(*data.Gray / 100), true, nil
+		}
+	case "OKLAB":
+		if data.OKLab != nil {
+			return color.NewOKLab(data.OKLab.L, data.OKLab.A, data.OKLab.B), true, nil
+		}
+	case "OKLCH":
+		if data.OKLCH != nil {
+			return color.NewOKLCH(data.OKLCH.L, data.OKLCH.C, data.OKLCH.H), true, nil
+		}
+	default:
+		if data.Values != nil {
+			c, err := i.parseGenericValues(data.Values, data.ColorSpace)
+			return c, true, err
+		}
+	}
+	return nil, false, nil
+}
+
+// parseHexColor parses a hex color string, with or without the # prefix.
 func (i *Importer) parseHexColor(hex string) (color.Color, error) {
 	if len(hex) == 0 {
 		return nil, fmt.Errorf("empty hex color")
 	}
 
-	// Remove # prefix if present
-	if hex[0] == '#' {
-		hex = hex[1:]
-	}
-
-	if len(hex) != 6 {
-		return nil, fmt.Errorf("invalid hex color length: %d", len(hex))
+	if hex[0] != '#' {
+		hex = "#" + hex
 	}
 
-	var r, g, b uint8
-	if _, err := fmt.Sscanf(hex, "%02x%02x%02x", &r, &g, &b); err != nil {
+	c, err := color.Parse(hex)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse hex color: %w", err)
 	}
-
-	return color.NewRGB(r, g, b), nil
+	return c, nil
 }
 
 // parseGenericValues parses generic color values based on color space.
@@ -290,30 +486,71 @@ func (i *Importer) parseGenericValues(values any, colorSpace string) (color.Colo
 		if len(nums) < 3 {
 			return nil, fmt.Errorf("insufficient RGB values")
 		}
-		return color.NewRGB(uint8(nums[0]), uint8(nums[1]), uint8(nums[2])), nil
+		return newRGB(nums[0], nums[1], nums[2]), nil
 
 	case "CMYK", "cmyk":
 		if len(nums) < 4 {
 			return nil, fmt.Errorf("insufficient CMYK values")
 		}
-		return color.NewCMYK(uint8(nums[0]), uint8(nums[1]), uint8(nums[2]), uint8(nums[3])), nil
+		return newCMYK(nums[0], nums[1], nums[2], nums[3]), nil
 
 	case "HSB", "hsb", "HSV", "hsv":
 		if len(nums) < 3 {
 			return nil, fmt.Errorf("insufficient HSB values")
 		}
-		return color.NewHSB(uint16(nums[0]), uint8(nums[1]), uint8(nums[2])), nil
+		return newHSB(nums[0], nums[1], nums[2]), nil
 
 	case "LAB", "lab":
 		if len(nums) < 3 {
 			return nil, fmt.Errorf("insufficient LAB values")
 		}
-		return color.NewLAB(int8(nums[0]), int8(nums[1]), int8(nums[2])), nil
+		return newLAB(nums[0], nums[1], nums[2]), nil
+
+	case "HSL", "hsl":
+		if len(nums) < 3 {
+			return nil, fmt.Errorf("insufficient HSL values")
+		}
+		return color.NewHSL(nums[0], nums[1]/100, nums[2]/100), nil
+
+	case "LCH", "lch":
+		if len(nums) < 3 {
+			return nil, fmt.Errorf("insufficient LCH values")
+		}
+		return color.NewLCH(nums[0], nums[1], nums[2]), nil
+
+	case "GRAY", "gray", "Gray":
+		if len(nums) < 1 {
+			return nil, fmt.Errorf("insufficient gray values")
+		}
+		return color.NewGray(nums[0] / 100), nil
+
+	case "OKLAB", "oklab", "OKLab":
+		if len(nums) < 3 {
+			return nil, fmt.Errorf("insufficient OKLab values")
+		}
+		return color.NewOKLab(nums[0], nums[1], nums[2]), nil
+
+	case "OKLCH", "oklch":
+		if len(nums) < 3 {
+			return nil, fmt.Errorf("insufficient OKLCH values")
+		}
+		return color.NewOKLCH(nums[0], nums[1], nums[2]), nil
+
+	case "XYZ", "xyz":
+		if len(nums) < 3 {
+			return nil, fmt.Errorf("insufficient XYZ values")
+		}
+		return color.NewXYZ(nums[0], nums[1], nums[2]), nil
 
 	default:
+		// Other registered spaces take the components of their constructor
+		if space, err := color.LookupColorSpace(colorSpace); err == nil {
+			return space.Create(nums...)
+		}
+
 		// Default to RGB if no color space specified
 		if len(nums) >= 3 {
-			return color.NewRGB(uint8(nums[0]), uint8(nums[1]), uint8(nums[2])), nil
+			return newRGB(nums[0], nums[1], nums[2]), nil
 		}
 	}
 
@@ -322,9 +559,14 @@ func (i *Importer) parseGenericValues(values any, colorSpace string) (color.Colo
 
 // tryParseColorValue attempts to parse a value as a color.
 func (i *Importer) tryParseColorValue(key string, value any) color.Color {
-	// Try hex string
+	// Try a CSS color function or hex string. Bare names are skipped so that
+	// ordinary strings such as "tan" are not mistaken for colors.
 	if str, ok := value.(string); ok {
-		if c, err := i.parseHexColor(str); err == nil {
+		if strings.Contains(str, "(") {
+			if c, err := color.Parse(str); err == nil {
+				return c
+			}
+		} else if c, err := i.parseHexColor(str); err == nil {
 			return c
 		}
 	}
@@ -341,28 +583,91 @@ func (i *Importer) tryParseColorValue(key string, value any) color.Color {
 		}
 
 		if len(nums) >= 3 {
-			return color.NewRGB(uint8(nums[0]), uint8(nums[1]), uint8(nums[2]))
+			return newRGB(nums[0], nums[1], nums[2])
 		}
 	}
 
 	return nil
 }
 
+// newRGB creates an RGB color from 0-255 values, keeping full precision for fractional values.
+func newRGB(r, g, b float64) color.Color {
+	if isWhole(r, g, b) {
+		return color.NewRGB(uint8(clamp(r, 0, 255)), uint8(clamp(g, 0, 255)), uint8(clamp(b, 0, 255)))
+	}
+	return color.NewRGB64(r/255, g/255, b/255)
+}
+
+// newCMYK creates a CMYK color from 0-100 values, keeping full precision for fractional values.
+func newCMYK(c, m, y, k float64) color.Color {
+	if isWhole(c, m, y, k) {
+		return color.NewCMYK(uint8(clamp(c, 0, 100)), uint8(clamp(m, 0, 100)), uint8(clamp(y, 0, 100)), uint8(clamp(k, 0, 100)))
+	}
+	return color.NewCMYK64(c/100, m/100, y/100, k/100)
+}
+
+// newHSB creates an HSB color (H: 0-360, S,B: 0-100), keeping full precision for fractional values.
+func newHSB(h, s, b float64) color.Color {
+	if isWhole(h, s, b) {
+		return color.NewHSB(uint16(clamp(h, 0, 65535)), uint8(clamp(s, 0, 100)), uint8(clamp(b, 0, 100)))
+	}
+	return color.NewHSB64(h, s/100, b/100)
+}
+
+// newLAB creates a LAB color, keeping full precision for fractional values.
+func newLAB(l, a, b float64) color.Color {
+	if isWhole(l, a, b) {
+		return color.NewLAB(int8(clamp(l, 0, 100)), int8(clamp(a, -128, 127)), int8(clamp(b, -128, 127)))
+	}
+	return color.NewLAB64(l, a, b)
+}
+
+// isWhole reports whether all values are integers.
+func isWhole(values ...float64) bool {
+	for _, v := range values {
+		if v != math.Trunc(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// clamp restricts v to the range [min, max].
+func clamp(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
+
+// round rounds v to the given number of decimal places. Negative places, such as
+// paletteio.FullPrecision, leave v unrounded.
+func round(v float64, places int) float64 {
+	if places < 0 {
+		return v
+	}
+	scale := math.Pow(10, float64(places))
+	return math.Round(v*scale) / scale
+}
+
 // Exporter implements exporting palettes to JSON format.
 type Exporter struct {
 	// PrettyPrint determines if the JSON should be formatted with indentation
 	PrettyPrint bool
-	// IncludeMetadata determines if palette metadata should be included
+	// IncludeMetadata determines if palette and per-color metadata should be included
 	IncludeMetadata bool
 	// ColorFormat specifies which color representations to include
 	ColorFormat ColorFormatFlags
+	// Precision is the number of decimal places written for color values, or
+	// paletteio.FullPrecision to write them unrounded. Zero writes whole numbers, except
+	// for OKLab, OKLCH, generic values and alpha which use four places.
+	Precision int
 }
 
 // ColorFormatFlags represents which color formats to include in the JSON.
 type ColorFormatFlags int
 
 const (
-	// FormatPrimary includes only the primary color space for each color
+	// FormatPrimary includes the color's own color space, so colors are read back
+	// without conversion. Colors without their own field, such as Display P3 and XYZ,
+	// are written as generic values of their registered color space, or else of XYZ.
 	FormatPrimary ColorFormatFlags = 1 << iota
 	// FormatRGB includes RGB values
 	FormatRGB
@@ -374,8 +679,20 @@ const (
 	FormatHSB
 	// FormatLAB includes LAB values
 	FormatLAB
+	// FormatHSL includes HSL values
+	FormatHSL
+	// FormatLCH includes LCH values
+	FormatLCH
+	// FormatGray includes the gray level (0-100)
+	FormatGray
+	// FormatOKLab includes OKLab values
+	FormatOKLab
+	// FormatOKLCH includes OKLCH values
+	FormatOKLCH
+	// FormatCSS includes a CSS Color Level 4 string
+	FormatCSS
 	// FormatAll includes all color representations
-	FormatAll = FormatRGB | FormatHex | FormatCMYK | FormatHSB | FormatLAB
+	FormatAll = FormatRGB | FormatHex | FormatCMYK | FormatHSB | FormatLAB | FormatHSL | FormatLCH | FormatGray | FormatOKLab | FormatOKLCH | FormatCSS
 )
 
 // NewExporter creates a new JSON exporter with default settings.
@@ -416,6 +733,9 @@ func (e *Exporter) Export(p *palette.Palette, w io.Writer) error {
 		}
 
 		colorJSON := e.convertColorToJSON(namedColor)
+		if e.IncludeMetadata && len(namedColor.Metadata) > 0 {
+			colorJSON.Metadata = namedColor.Metadata
+		}
 		paletteData.Colors = append(paletteData.Colors, colorJSON)
 	}
 
@@ -459,31 +779,206 @@ func (e *Exporter) convertColorToJSON(namedColor palette.NamedColor) ColorJSON {
 		ColorSpace: namedColor.Color.ColorSpace(),
 	}
 
+	alpha, hasAlpha := namedColor.Color.(color.AlphaColor)
+
+	// The process color, without alpha or spot ink
+	c := color.Opaque(namedColor.Color)
+	if spot, ok := color.AsSpot(c); ok {
+		colorJSON.Spot = &SpotValues{Ink: spot.Ink, Book: spot.Book}
+		c = color.Opaque(spot.Color)
+	}
+
+	// FormatPrimary adds the color's own space, or generic values for spaces without their own field
+	formats := e.ColorFormat
+	if formats&FormatPrimary != 0 {
+		if own := primaryFormat(c); own != 0 {
+			formats |= own
+		} else {
+			space, values := primaryValues(c)
+			for i, v := range values {
+				values[i] = round(v, e.fractionPrecision())
+			}
+			colorJSON.ColorSpace, colorJSON.Values = space, values
+		}
+	}
+
 	// Include requested color formats
-	if e.ColorFormat&FormatRGB != 0 || e.ColorFormat&FormatPrimary != 0 {
-		rgb := namedColor.Color.ToRGB()
-		colorJSON.RGB = &RGBValues{R: rgb.R, G: rgb.G, B: rgb.B}
+	// Whole-number colors are written as they are, without a trip through float64
+	if formats&FormatRGB != 0 {
+		var values RGBValues
+		if _, whole := c.(color.RGB); whole || e.Precision == 0 {
+			rgb := c.ToRGB()
+			values = RGBValues{R: float64(rgb.R), G: float64(rgb.G), B: float64(rgb.B)}
+		} else {
+			rgb := c.ToRGB64()
+			values = RGBValues{R: e.round(rgb.R * 255), G: e.round(rgb.G * 255), B: e.round(rgb.B * 255)}
+		}
+		if hasAlpha {
+			colorJSON.RGBA = &RGBAValues{R: values.R, G: values.G, B: values.B, A: round(alpha.Alpha, e.fractionPrecision())}
+		} else {
+			colorJSON.RGB = &values
+		}
+	}
+	// Values in the color's own space are read back first, so they need the alpha beside them
+	if hasAlpha && formats&FormatPrimary != 0 && primaryFormat(c) != FormatRGB {
+		a := round(alpha.Alpha, e.fractionPrecision())
+		colorJSON.Alpha = &a
 	}
 
-	if e.ColorFormat&FormatHex != 0 {
-		rgb := namedColor.Color.ToRGB()
+	if formats&FormatHex != 0 {
+		rgb := c.ToRGB()
 		colorJSON.Hex = fmt.Sprintf("#%02X%02X%02X", rgb.R, rgb.G, rgb.B)
+		if hasAlpha {
+			colorJSON.Hex += fmt.Sprintf("%02X", uint8(math.Round(alpha.Alpha*255)))
+		}
+	}
+
+	if formats&FormatCSS != 0 {
+		colorJSON.CSS = color.FormatCSS(namedColor.Color)
+	}
+
+	if formats&FormatCMYK != 0 {
+		if _, whole := c.(color.CMYK); whole || e.Precision == 0 {
+			cmyk := c.ToCMYK()
+			colorJSON.CMYK = &CMYKValues{C: float64(cmyk.C), M: float64(cmyk.M), Y: float64(cmyk.Y), K: float64(cmyk.K)}
+		} else {
+			cmyk := c.ToCMYK64()
+			colorJSON.CMYK = &CMYKValues{C: e.round(cmyk.C * 100), M: e.round(cmyk.M * 100), Y: e.round(cmyk.Y * 100), K: e.round(cmyk.K * 100)}
+		}
+	}
+
+	if formats&FormatHSB != 0 {
+		if _, whole := c.(color.HSB); whole || e.Precision == 0 {
+			hsb := c.ToHSB()
+			colorJSON.HSB = &HSBValues{H: float64(hsb.H), S: float64(hsb.S), B: float64(hsb.B)}
+		} else {
+			hsb := c.ToHSB64()
+			colorJSON.HSB = &HSBValues{H: e.round(hsb.H), S: e.round(hsb.S * 100), B: e.round(hsb.B * 100)}
+		}
+	}
+
+	if formats&FormatLAB != 0 {
+		if _, whole := c.(color.LAB); whole || e.Precision == 0 {
+			lab := c.ToLAB()
+			colorJSON.LAB = &LABValues{L: float64(lab.L), A: float64(lab.A), B: float64(lab.B)}
+		} else {
+			lab := c.ToLAB64()
+			colorJSON.LAB = &LABValues{L: e.round(lab.L), A: e.round(lab.A), B: e.round(lab.B)}
+		}
+	}
+
+	if formats&FormatHSL != 0 {
+		hsl, ok := c.(color.HSL)
+		if !ok {
+			hsl = c.ToXYZ().ToHSL()
+		}
+		colorJSON.HSL = &HSLValues{H: e.round(hsl.H), S: e.round(hsl.S * 100), L: e.round(hsl.L * 100)}
+	}
+
+	if formats&FormatLCH != 0 {
+		lch, ok := c.(color.LCH)
+		if !ok || lch.WhitePoint() != color.D65 {
+			lch = c.ToXYZ().ToLCH()
+		}
+		colorJSON.LCH = &LCHValues{L: e.round(lch.L), C: e.round(lch.C), H: e.round(lch.H)}
 	}
 
-	if e.ColorFormat&FormatCMYK != 0 {
-		cmyk := namedColor.Color.ToCMYK()
-		colorJSON.CMYK = &CMYKValues{C: cmyk.C, M: cmyk.M, Y: cmyk.Y, K: cmyk.K}
+	if formats&FormatGray != 0 {
+		gray, ok := c.(color.Gray)
+		if !ok {
+			gray = c.ToXYZ().ToGray()
+		}
+		y := e.round(gray.Y * 100)
+		colorJSON.Gray = &y
 	}
 
-	if e.ColorFormat&FormatHSB != 0 {
-		hsb := namedColor.Color.ToHSB()
-		colorJSON.HSB = &HSBValues{H: hsb.H, S: hsb.S, B: hsb.B}
+	if formats&FormatOKLab != 0 {
+		ok, isOKLab := c.(color.OKLab)
+		if !isOKLab {
+			ok = c.ToXYZ().ToOKLab()
+		}
+		places := e.fractionPrecision()
+		colorJSON.OKLab = &OKLabValues{L: round(ok.L, places), A: round(ok.A, places), B: round(ok.B, places)}
 	}
 
-	if e.ColorFormat&FormatLAB != 0 {
-		lab := namedColor.Color.ToLAB()
-		colorJSON.LAB = &LABValues{L: lab.L, A: lab.A, B: lab.B}
+	if formats&FormatOKLCH != 0 {
+		ok, isOKLCH := c.(color.OKLCH)
+		if !isOKLCH {
+			ok = c.ToXYZ().ToOKLCH()
+		}
+		places := e.fractionPrecision()
+		colorJSON.OKLCH = &OKLCHValues{L: round(ok.L, places), C: round(ok.C, places), H: round(ok.H, places)}
 	}
 
 	return colorJSON
 }
+
+// primaryFormat returns the format that holds a color in its own space, or 0 for
+// spaces without their own field.
+func primaryFormat(c color.Color) ColorFormatFlags {
+	switch c.(type) {
+	case color.RGB, color.RGB64:
+		return FormatRGB
+	case color.CMYK, color.CMYK64:
+		return FormatCMYK
+	case color.HSB, color.HSB64:
+		return FormatHSB
+	case color.LAB, color.LAB64:
+		return FormatLAB
+	case color.HSL:
+		return FormatHSL
+	case color.LCH:
+		return FormatLCH
+	case color.Gray:
+		return FormatGray
+	case color.OKLab:
+		return FormatOKLab
+	case color.OKLCH:
+		return FormatOKLCH
+	default:
+		return 0
+	}
+}
+
+// primaryValues returns the color space and generic values of a color without its
+// own field: registered RGB working spaces as their 0-1 components and other colors as XYZ.
+func primaryValues(c color.Color) (string, []float64) {
+	if rgb, ok := c.(color.SpaceRGB); ok {
+		if space, err := color.LookupColorSpace(rgb.Space.Name); err == nil && space.Gamut == rgb.Space {
+			return rgb.Space.Name, []float64{rgb.R, rgb.G, rgb.B}
+		}
+	}
+	xyz := c.ToXYZ()
+	return "XYZ", []float64{xyz.X, xyz.Y, xyz.Z}
+}
+
+// WithPrecision returns a copy of the exporter that writes values with the given
+// number of decimal places, or unrounded for paletteio.FullPrecision.
+func (e *Exporter) WithPrecision(places int) paletteio.Exporter {
+	rounded := *e
+	rounded.Precision = places
+	return &rounded
+}
+
+// Lossless returns a copy of the exporter that also writes each color in its own color
+// space (FormatPrimary), unrounded, so every color is read back as it was.
+func (e *Exporter) Lossless() paletteio.Exporter {
+	lossless := *e
+	lossless.ColorFormat |= FormatPrimary
+	lossless.Precision = paletteio.FullPrecision
+	return &lossless
+}
+
+// round rounds v to the exporter's precision.
+func (e *Exporter) round(v float64) float64 {
+	return round(v, e.Precision)
+}
+
+// fractionPrecision returns the decimal places used for OKLab, OKLCH, generic and
+// alpha values, which are too small to be written as whole numbers.
+func (e *Exporter) fractionPrecision() int {
+	if e.Precision != 0 {
+		return e.Precision
+	}
+	return 4
+}
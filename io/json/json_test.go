@@ -2,10 +2,13 @@ package json
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/kennyp/palette/color"
+	paletteio "github.com/kennyp/palette/io"
 	"github.com/kennyp/palette/palette"
 )
 
@@ -158,6 +161,7 @@ func TestImportHexColor(t *testing.T) {
 		"Green":       {"#00FF00", color.NewRGB(0, 255, 0)},
 		"Blue":        {"#0000FF", color.NewRGB(0, 0, 255)},
 		"Without hash": {"FF0000", color.NewRGB(255, 0, 0)},
+		"Short":       {"#F00", color.NewRGB(255, 0, 0)},
 	}
 	
 	for name, tt := range tests {
@@ -179,7 +183,7 @@ func TestImportHexColor(t *testing.T) {
 		t.Errorf("parseHexColor() should error for invalid hex")
 	}
 	
-	_, err = importer.parseHexColor("#FF00")
+	_, err = importer.parseHexColor("#FF000")
 	if err == nil {
 		t.Errorf("parseHexColor() should error for wrong length")
 	}
@@ -742,4 +746,399 @@ func TestJSONRoundTrip(t *testing.T) {
 			}
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestPrecisionRoundTrip(t *testing.T) {
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatCMYK
+	exporter.Precision = 4
+
+	original := palette.New("Precision")
+	original.Add(color.NewCMYK64(0.12345, 0.5, 0, 0.0001), "Precise")
+
+	var output strings.Builder
+	if err := exporter.Export(original, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	if !strings.Contains(output.String(), `"c": 12.345`) {
+		t.Errorf("Export() should contain fractional CMYK values, got %s", output.String())
+	}
+
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	got, _ := imported.Get(0)
+	want := color.NewCMYK64(0.12345, 0.5, 0, 0.0001)
+	if got.Color != want {
+		t.Errorf("Round trip = %v, want %v", got.Color, want)
+	}
+}
+
+func TestOKLabRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		format ColorFormatFlags
+		color  color.Color
+		want   string
+	}{
+		"OKLab": {FormatOKLab, color.NewOKLab(0.628, 0.2249, 0.1258), `"oklab": {`},
+		"OKLCH": {FormatOKLCH, color.NewOKLCH(0.628, 0.2577, 29.23), `"oklch": {`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			exporter := NewExporter()
+			exporter.ColorFormat = tt.format
+
+			original := palette.New("Perceptual")
+			original.Add(tt.color, name)
+
+			var output strings.Builder
+			if err := exporter.Export(original, &output); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			if !strings.Contains(output.String(), tt.want) {
+				t.Errorf("Export() should contain %s, got %s", tt.want, output.String())
+			}
+
+			imported, err := NewImporter().Import(strings.NewReader(output.String()))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			got, _ := imported.Get(0)
+			if got.Color != tt.color {
+				t.Errorf("Round trip = %v, want %v", got.Color, tt.color)
+			}
+		})
+	}
+}
+
+func TestImportOKLCHValues(t *testing.T) {
+	jsonData := `[{"name": "Accent", "color_space": "oklch", "values": [0.7, 0.1, 250]}]`
+
+	p, err := NewImporter().Import(strings.NewReader(jsonData))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	got, _ := p.Get(0)
+	want := color.NewOKLCH(0.7, 0.1, 250)
+	if got.Color != want {
+		t.Errorf("Import() = %v, want %v", got.Color, want)
+	}
+}
+
+func TestImportCylindrical(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		want color.Color
+	}{
+		"HSL object": {`[{"hsl": {"h": 210, "s": 50, "l": 40}}]`, color.NewHSL(210, 0.5, 0.4)},
+		"LCH object": {`[{"lch": {"l": 54.29, "c": 106.84, "h": 40.86}}]`, color.NewLCH(54.29, 106.84, 40.86)},
+		"HSL values": {`[{"color_space": "hsl", "values": [210, 50, 40]}]`, color.NewHSL(210, 0.5, 0.4)},
+		"LCH values": {`[{"color_space": "LCH", "values": [50, 30, 120]}]`, color.NewLCH(50, 30, 120)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewImporter().Import(strings.NewReader(tt.json))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			got, _ := p.Get(0)
+			if got.Color != tt.want {
+				t.Errorf("Import() = %v, want %v", got.Color, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportCylindrical(t *testing.T) {
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatHSL | FormatLCH
+
+	p := palette.New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	for _, want := range []string{`"hsl": {`, `"l": 50`, `"lch": {`, `"c": 105`} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("Export() should contain %s, got %s", want, output.String())
+		}
+	}
+}
+
+func TestGrayRoundTrip(t *testing.T) {
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatGray
+
+	original := palette.New("Grays")
+	original.Add(color.NewGray(0.25), "Dark")
+
+	var output strings.Builder
+	if err := exporter.Export(original, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	if !strings.Contains(output.String(), `"gray": 25`) {
+		t.Errorf("Export() should contain gray value, got %s", output.String())
+	}
+
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	got, _ := imported.Get(0)
+	if want := color.NewGray(0.25); got.Color != want {
+		t.Errorf("Round trip = %v, want %v", got.Color, want)
+	}
+}
+
+func TestAlpha(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		want color.Color
+	}{
+		"RGBA object": {`[{"rgba": {"r": 255, "g": 0, "b": 0, "a": 0.5}}]`, color.WithAlpha(color.NewRGB(255, 0, 0), 0.5)},
+		"8-digit hex": {`[{"hex": "#FF000080"}]`, color.WithAlpha(color.NewRGB(255, 0, 0), 128.0/255)},
+		"6-digit hex": {`[{"hex": "#FF0000"}]`, color.NewRGB(255, 0, 0)},
+		"CSS":         {`[{"css": "rgb(255 0 0 / 50%)"}]`, color.WithAlpha(color.NewRGB(255, 0, 0), 0.5)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewImporter().Import(strings.NewReader(tt.json))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+
+			got, _ := p.Get(0)
+			if got.Color != tt.want {
+				t.Errorf("Import() = %v, want %v", got.Color, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportAlpha(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.WithAlpha(color.NewRGB(255, 0, 0), 0.5), "Glass")
+
+	var output strings.Builder
+	if err := NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	for _, want := range []string{`"rgba": {`, `"a": 0.5`, `"hex": "#FF000080"`} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("Export() should contain %s, got %s", want, output.String())
+		}
+	}
+	if strings.Contains(output.String(), `"rgb": {`) {
+		t.Errorf("Export() should write rgba instead of rgb, got %s", output.String())
+	}
+
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	got, _ := imported.Get(0)
+	if color.AlphaOf(got.Color) != 0.5 {
+		t.Errorf("Round trip alpha = %v, want 0.5", color.AlphaOf(got.Color))
+	}
+
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatCMYK
+	if losses := exporter.Losses(p); len(losses) != 1 {
+		t.Errorf("Losses() = %v, want alpha loss for CMYK-only export", losses)
+	}
+}
+
+func TestCSS(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewOKLCH(0.7, 0.1, 250), "Sky")
+
+	exporter := NewExporter()
+	exporter.ColorFormat = FormatCSS
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if want := `"css": "oklch(0.7 0.1 250)"`; !strings.Contains(output.String(), want) {
+		t.Errorf("Export() should contain %s, got %s", want, output.String())
+	}
+
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if got, _ := imported.Get(0); got.Color != color.NewOKLCH(0.7, 0.1, 250) {
+		t.Errorf("Round trip = %v, want %v", got.Color, color.NewOKLCH(0.7, 0.1, 250))
+	}
+
+	// Generic objects accept CSS functions but not bare names
+	generic, err := NewImporter().Import(strings.NewReader(`{"accent": "hsl(120 100% 25%)", "title": "tan"}`))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if generic.Len() != 1 {
+		t.Errorf("Import() generic colors = %d, want 1", generic.Len())
+	}
+}
+
+func TestSpot(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", color.NewRGB(228, 0, 43)), "185")
+
+	var output strings.Builder
+	if err := NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	for _, want := range []string{`"spot": {`, `"ink": "PANTONE 185 C"`, `"book": "PANTONE+ Solid Coated"`} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("Export() should contain %s, got %s", want, output.String())
+		}
+	}
+
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	got, _ := imported.Get(0)
+	if want := p.Colors[0].Color; got.Color != want {
+		t.Errorf("Round trip = %v, want %v", got.Color, want)
+	}
+
+	// Alpha stays outside the spot ink
+	glass, err := NewImporter().Import(strings.NewReader(`[{"spot": {"ink": "Ink"}, "rgba": {"r": 255, "g": 0, "b": 0, "a": 0.5}}]`))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	nc, _ := glass.Get(0)
+	if _, ok := color.AsSpot(nc.Color); !ok || color.AlphaOf(nc.Color) != 0.5 {
+		t.Errorf("Import() = %v, want a spot ink with alpha 0.5", nc.Color)
+	}
+}
+
+func TestColorMetadata(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewRGB(228, 0, 43), "Red")
+	p.Add(color.NewRGB(0, 0, 255), "Blue")
+	p.Colors[0].SetMetadata(palette.MetadataKey, "RED001")
+	p.Colors[0].SetMetadata(palette.MetadataTags, []string{"brand", "primary"})
+
+	var output strings.Builder
+	if err := NewExporter().Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	for i, want := range p.Colors {
+		got, _ := imported.Get(i)
+		if !reflect.DeepEqual(got.Metadata, want.Metadata) {
+			t.Errorf("Round trip color %d metadata = %v, want %v", i, got.Metadata, want.Metadata)
+		}
+	}
+
+	exporter := NewExporter()
+	exporter.IncludeMetadata = false
+	output.Reset()
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if strings.Contains(output.String(), "RED001") {
+		t.Errorf("Export() without metadata = %s, should not contain color metadata", output.String())
+	}
+}
+
+func TestImportAutoName(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		want []string
+	}{
+		"Palette": {
+			`{"name": "Test", "colors": [{"hex": "#FF6347"}, {"name": "Brand", "hex": "#000080"}]}`,
+			[]string{"tomato", "Brand"},
+		},
+		"Array": {
+			`[{"hex": "#FFFFFF"}, {"hex": "#FEFEFE"}]`,
+			[]string{"white", "white 2"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			importer := &Importer{AutoName: color.CSSNames()}
+			p, err := importer.Import(strings.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			for i, want := range tt.want {
+				if nc, _ := p.Get(i); nc.Name != want {
+					t.Errorf("Import() color %d name = %q, want %q", i, nc.Name, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLosslessExport(t *testing.T) {
+	p := palette.New("Test")
+	p.Add(color.NewRGB64(0.123456, 0.654321, 0.999), "RGB64")
+	p.Add(color.NewCMYK64(0.1, 0.25, 0.333, 0.05), "CMYK64")
+	p.Add(color.NewOKLCH(0.7, 0.3, 150), "Out of sRGB")
+	p.Add(color.WithAlpha(color.NewOKLab(0.5, 0.1, -0.1), 0.25), "Glass")
+	p.Add(color.NewXYZ(0.2, 0.3, 0.4), "XYZ")
+	p.Add(color.DisplayP3.New(0.1, 0.9, 0.2), "P3")
+	p.Add(color.NewSpot("Ink", "Book", color.NewCMYK64(0, 0.9, 0.8, 0.01)), "Spot")
+	p.Add(color.NewCMYK(0, 29, 57, 0), "CMYK")
+
+	exporter := NewExporter().Lossless()
+	var output strings.Builder
+	if err := exporter.Export(p, &output); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	imported, err := NewImporter().Import(strings.NewReader(output.String()))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	for i, want := range p.Colors {
+		got, _ := imported.Get(i)
+		if fmt.Sprintf("%T", got.Color) != fmt.Sprintf("%T", want.Color) {
+			t.Errorf("Round trip %s = %T, want %T", want.Name, got.Color, want.Color)
+		}
+		if d := color.DeltaE(got.Color, want.Color, color.DeltaECIE76); d > 1e-9 || color.AlphaOf(got.Color) != color.AlphaOf(want.Color) {
+			t.Errorf("Round trip %s = %v, want %v", want.Name, got.Color, want.Color)
+		}
+	}
+
+	if losses := exporter.(*Exporter).Losses(p); len(losses) != 0 {
+		t.Errorf("Losses() = %v, want none", losses)
+	}
+
+	// The default whole RGB values round the first color and clip the third
+	var lost []string
+	for _, loss := range NewExporter().Losses(p) {
+		if loss.Property == paletteio.LossPrecision {
+			lost = append(lost, loss.Name)
+		}
+	}
+	if !slices.Contains(lost, "RGB64") || !slices.Contains(lost, "Out of sRGB") {
+		t.Errorf("Losses() with whole RGB values = %v, want RGB64 and Out of sRGB", lost)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/kennyp/palette/io/colorbook"
 	"github.com/kennyp/palette/io/colorswatch"
 	"github.com/kennyp/palette/io/csv"
+	"github.com/kennyp/palette/io/imagepalette"
 	"github.com/kennyp/palette/io/json"
 )
 
@@ -26,4 +27,8 @@ func init() {
 	// JSON
 	paletteio.DefaultRegistry.RegisterImporter(json.NewImporter())
 	paletteio.DefaultRegistry.RegisterExporter(json.NewExporter())
+
+	// Stock image/color/palette palettes (import only)
+	paletteio.DefaultRegistry.RegisterImporter(imagepalette.NewPlan9Importer())
+	paletteio.DefaultRegistry.RegisterImporter(imagepalette.NewWebSafeImporter())
 }
@@ -0,0 +1,58 @@
+package palette
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/kennyp/palette/color"
+)
+
+// SortByCCT returns a copy of the palette with its colors sorted by correlated color
+// temperature, from warm (low kelvin) to cool. Colors without a CCT, such as black,
+// and colors too far from the Planckian locus to have a meaningful temperature (see
+// color.CCTMaxDuv) follow the others in their original order. Names and metadata are kept.
+func (p *Palette) SortByCCT() *Palette {
+	type keyed struct {
+		color  NamedColor
+		kelvin float64
+		hasCCT bool
+	}
+
+	keys := make([]keyed, len(p.Colors))
+	for i, c := range p.Colors {
+		kelvin, duv, ok := color.CCT(c.Color)
+		keys[i] = keyed{c, kelvin, ok && math.Abs(duv) <= color.CCTMaxDuv}
+	}
+
+	slices.SortStableFunc(keys, func(a, b keyed) int {
+		if a.hasCCT != b.hasCCT {
+			if a.hasCCT {
+				return -1
+			}
+			return 1
+		}
+		if !a.hasCCT {
+			return 0
+		}
+		return cmp.Compare(a.kelvin, b.kelvin)
+	})
+
+	sorted := p.Clone()
+	for i, k := range keys {
+		sorted.Colors[i] = k.color
+	}
+
+	return sorted
+}
+
+// FilterByCCT returns a new palette containing only colors whose correlated color
+// temperature is between minKelvin and maxKelvin inclusive. Colors without a CCT and
+// colors too far from the Planckian locus to have a meaningful temperature (see
+// color.CCTMaxDuv) are left out.
+func (p *Palette) FilterByCCT(minKelvin, maxKelvin float64) *Palette {
+	return p.Filter(func(c NamedColor) bool {
+		kelvin, duv, ok := color.CCT(c.Color)
+		return ok && math.Abs(duv) <= color.CCTMaxDuv && kelvin >= minKelvin && kelvin <= maxKelvin
+	})
+}
@@ -0,0 +1,72 @@
+package palette
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func cctPalette() *Palette {
+	p := New("Lights")
+	p.Add(color.FromKelvin(6500), "Daylight")
+	p.Add(color.NewRGB(0, 0, 255), "Blue")
+	p.Add(color.FromKelvin(2700), "Warm White")
+	p.Add(color.NewRGB(0, 255, 0), "Green")
+	p.Add(color.NewRGB(0, 0, 0), "Black")
+	p.Add(color.FromKelvin(4000), "Neutral White")
+	p.Add(color.FromKelvin(1900), "Candle")
+	p.SetMetadata("version", 1)
+	return p
+}
+
+func TestSortByCCT(t *testing.T) {
+	p := cctPalette()
+	sorted := p.SortByCCT()
+
+	var names []string
+	for _, c := range sorted.Colors {
+		names = append(names, c.Name)
+	}
+	want := []string{"Candle", "Warm White", "Neutral White", "Daylight", "Blue", "Green", "Black"}
+	if !slices.Equal(names, want) {
+		t.Errorf("SortByCCT() = %v, want %v", names, want)
+	}
+
+	if sorted.Name != "Lights" {
+		t.Errorf("SortByCCT() name = %q, want Lights", sorted.Name)
+	}
+	if v, ok := sorted.GetMetadata("version"); !ok || v != 1 {
+		t.Errorf("SortByCCT() metadata version = %v, %v", v, ok)
+	}
+
+	// The original is unchanged
+	if p.Colors[0].Name != "Daylight" {
+		t.Errorf("original first color changed to %q", p.Colors[0].Name)
+	}
+}
+
+func TestFilterByCCT(t *testing.T) {
+	tests := map[string]struct {
+		min, max float64
+		want     []string
+	}{
+		"Warm":    {1000, 3500, []string{"Warm White", "Candle"}},
+		"Neutral": {3999, 4001, []string{"Neutral White"}},
+		"Cool":    {5000, 100000, []string{"Daylight"}},
+		"All":     {0, 1e6, []string{"Daylight", "Warm White", "Neutral White", "Candle"}},
+		"None":    {7000, 8000, nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var names []string
+			for _, c := range cctPalette().FilterByCCT(tt.min, tt.max).Colors {
+				names = append(names, c.Name)
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("FilterByCCT(%g, %g) = %v, want %v", tt.min, tt.max, names, tt.want)
+			}
+		})
+	}
+}
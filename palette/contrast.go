@@ -0,0 +1,49 @@
+package palette
+
+import "github.com/kennyp/palette/color"
+
+// ContrastPair is the contrast of one palette color used as text on another used as
+// the background.
+type ContrastPair struct {
+	Foreground     int     `json:"foreground"`      // Index of the text color
+	ForegroundName string  `json:"foreground_name"` // Name of the text color
+	Background     int     `json:"background"`      // Index of the background color
+	BackgroundName string  `json:"background_name"` // Name of the background color
+	Ratio          float64 `json:"ratio"`           // WCAG 2.1 contrast ratio, 1-21
+	APCA           float64 `json:"apca"`            // APCA lightness contrast (Lc)
+	AA             bool    `json:"aa"`              // Passes WCAG AA for normal text
+	AALarge        bool    `json:"aa_large"`        // Passes WCAG AA for large text
+	AAA            bool    `json:"aaa"`             // Passes WCAG AAA for normal text
+	AAALarge       bool    `json:"aaa_large"`       // Passes WCAG AAA for large text
+}
+
+// Contrast returns the contrast of foreground text on a background.
+func Contrast(foreground, background NamedColor) ContrastPair {
+	ratio := color.ContrastRatio(foreground.Color, background.Color)
+	return ContrastPair{
+		ForegroundName: foreground.Name,
+		BackgroundName: background.Name,
+		Ratio:          ratio,
+		APCA:           color.APCAContrast(foreground.Color, background.Color),
+		AA:             ratio >= color.ContrastAA,
+		AALarge:        ratio >= color.ContrastAALarge,
+		AAA:            ratio >= color.ContrastAAA,
+		AAALarge:       ratio >= color.ContrastAAALarge,
+	}
+}
+
+// ContrastMatrix returns the contrast of every palette color as text on every palette
+// color as background. Row i holds color i as the foreground; column j color j as the
+// background.
+func (p *Palette) ContrastMatrix() [][]ContrastPair {
+	matrix := make([][]ContrastPair, len(p.Colors))
+	for i, fg := range p.Colors {
+		matrix[i] = make([]ContrastPair, len(p.Colors))
+		for j, bg := range p.Colors {
+			pair := Contrast(fg, bg)
+			pair.Foreground, pair.Background = i, j
+			matrix[i][j] = pair
+		}
+	}
+	return matrix
+}
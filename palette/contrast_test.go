@@ -0,0 +1,72 @@
+package palette
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestContrast(t *testing.T) {
+	white := NamedColor{Name: "White", Color: color.NewRGB(255, 255, 255)}
+
+	tests := map[string]struct {
+		foreground                   NamedColor
+		wantAA, wantAALarge, wantAAA bool
+		wantAAALarge                 bool
+	}{
+		"Black":        {NamedColor{Name: "Black", Color: color.NewRGB(0, 0, 0)}, true, true, true, true},
+		"Dark gray":    {NamedColor{Name: "#595959", Color: color.NewRGB(0x59, 0x59, 0x59)}, true, true, true, true},
+		"Mid gray":     {NamedColor{Name: "#767676", Color: color.NewRGB(0x76, 0x76, 0x76)}, true, true, false, true},
+		"Light gray":   {NamedColor{Name: "#949494", Color: color.NewRGB(0x94, 0x94, 0x94)}, false, true, false, false},
+		"Pale gray":    {NamedColor{Name: "#CCCCCC", Color: color.NewRGB(0xcc, 0xcc, 0xcc)}, false, false, false, false},
+		"Same as back": {white, false, false, false, false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Contrast(tt.foreground, white)
+			if got.AA != tt.wantAA || got.AALarge != tt.wantAALarge || got.AAA != tt.wantAAA || got.AAALarge != tt.wantAAALarge {
+				t.Errorf("Contrast() ratio %.2f = AA %v, AA large %v, AAA %v, AAA large %v; want %v, %v, %v, %v",
+					got.Ratio, got.AA, got.AALarge, got.AAA, got.AAALarge, tt.wantAA, tt.wantAALarge, tt.wantAAA, tt.wantAAALarge)
+			}
+			if got.ForegroundName != tt.foreground.Name || got.BackgroundName != "White" {
+				t.Errorf("Contrast() names = %q on %q", got.ForegroundName, got.BackgroundName)
+			}
+		})
+	}
+}
+
+func TestContrastMatrix(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(0, 0, 0), "Black")
+	p.Add(color.NewRGB(255, 255, 255), "White")
+	p.Add(color.NewRGB(0, 102, 204), "Blue")
+
+	matrix := p.ContrastMatrix()
+	if len(matrix) != 3 {
+		t.Fatalf("ContrastMatrix() rows = %d, want 3", len(matrix))
+	}
+
+	for i, row := range matrix {
+		if len(row) != 3 {
+			t.Fatalf("ContrastMatrix() row %d length = %d, want 3", i, len(row))
+		}
+		for j, pair := range row {
+			if pair.Foreground != i || pair.Background != j {
+				t.Errorf("matrix[%d][%d] indexes = %d, %d", i, j, pair.Foreground, pair.Background)
+			}
+			if math.Abs(pair.Ratio-matrix[j][i].Ratio) > 1e-9 {
+				t.Errorf("matrix[%d][%d] ratio %.4f differs from transpose %.4f", i, j, pair.Ratio, matrix[j][i].Ratio)
+			}
+		}
+		if row[i].Ratio != 1 || row[i].APCA != 0 {
+			t.Errorf("matrix[%d][%d] = ratio %v, APCA %v, want 1 and 0", i, i, row[i].Ratio, row[i].APCA)
+		}
+	}
+
+	// APCA is signed by polarity: dark text on light is positive
+	if blackOnWhite := matrix[0][1]; blackOnWhite.APCA <= 0 || matrix[1][0].APCA >= 0 {
+		t.Errorf("APCA black on white = %.2f, white on black = %.2f", blackOnWhite.APCA, matrix[1][0].APCA)
+	}
+}
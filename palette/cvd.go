@@ -0,0 +1,23 @@
+package palette
+
+import (
+	"fmt"
+
+	"github.com/kennyp/palette/color"
+)
+
+// SimulateCVD returns a copy of the palette showing how its colors appear to someone
+// with the given color vision deficiency at the given severity (0-1); see
+// color.SimulateCVD. Names, alpha and metadata are kept, but spot colors become plain
+// sRGB colors, as the simulated appearance is no longer the ink. The copy's name notes
+// the simulation, such as "Brand (Deutan simulation)".
+func (p *Palette) SimulateCVD(deficiency color.Deficiency, severity float64) *Palette {
+	simulated := p.Clone()
+	simulated.Name = fmt.Sprintf("%s (%s simulation)", p.Name, deficiency)
+
+	for i, c := range simulated.Colors {
+		simulated.Colors[i].Color = color.SimulateCVD(c.Color, deficiency, severity)
+	}
+
+	return simulated
+}
@@ -0,0 +1,45 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestSimulateCVD(t *testing.T) {
+	p := New("Brand")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+	p.Add(color.WithAlpha(color.NewRGB(0, 128, 0), 0.5), "Glass Green")
+	p.Add(color.NewSpot("PANTONE 300 C", "PANTONE+ Solid Coated", color.NewRGB(0, 94, 184)), "Pantone Blue")
+	p.SetMetadata("version", 1)
+
+	simulated := p.SimulateCVD(color.DeficiencyDeutan, 1)
+
+	if simulated.Name != "Brand (Deutan simulation)" {
+		t.Errorf("SimulateCVD() name = %q", simulated.Name)
+	}
+	if v, ok := simulated.GetMetadata("version"); !ok || v != 1 {
+		t.Errorf("SimulateCVD() metadata version = %v, %v", v, ok)
+	}
+
+	for i, c := range simulated.Colors {
+		if c.Name != p.Colors[i].Name {
+			t.Errorf("color %d name = %q, want %q", i, c.Name, p.Colors[i].Name)
+		}
+		if want := color.SimulateCVD(p.Colors[i].Color, color.DeficiencyDeutan, 1); c.Color != want {
+			t.Errorf("color %d = %v, want %v", i, c.Color, want)
+		}
+	}
+
+	if color.AlphaOf(simulated.Colors[1].Color) != 0.5 {
+		t.Errorf("SimulateCVD() alpha = %v, want 0.5", color.AlphaOf(simulated.Colors[1].Color))
+	}
+	if _, ok := color.AsSpot(simulated.Colors[2].Color); ok {
+		t.Error("SimulateCVD() kept a spot color")
+	}
+
+	// The original is unchanged
+	if p.Colors[0].Color != color.NewRGB(255, 0, 0) {
+		t.Errorf("original color changed to %v", p.Colors[0].Color)
+	}
+}
@@ -0,0 +1,83 @@
+package palette
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kennyp/palette/color"
+)
+
+// Easing maps an evenly spaced position between 0 and 1 onto the position sampled
+// along a gradient, so that steps can bunch up at either end.
+type Easing func(t float64) float64
+
+// EaseLinear samples the gradient evenly.
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseIn starts slowly, placing more steps near the first stop.
+func EaseIn(t float64) float64 {
+	return t * t
+}
+
+// EaseOut ends slowly, placing more steps near the last stop.
+func EaseOut(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// EaseInOut starts and ends slowly, placing more steps near both ends.
+func EaseInOut(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// GradientOptions controls how a gradient is generated. The zero value blends in
+// OKLab with evenly spaced steps.
+type GradientOptions struct {
+	Space  color.InterpolationSpace // Space the stops are blended in
+	Hue    color.HueInterpolation   // Hue direction for LCh and OKLCH
+	Easing Easing                   // Spacing of the steps; nil means EaseLinear
+}
+
+// Gradient returns n colors spread along the stops, from the first stop to the last.
+// Stops are equally spaced along the gradient, and each pair is blended as described
+// by opts. Colors are named "Step 1", "Step 2" and so on.
+func Gradient(stops []color.Color, n int, opts GradientOptions) ([]NamedColor, error) {
+	if len(stops) < 2 {
+		return nil, fmt.Errorf("gradient needs at least 2 stops, got %d", len(stops))
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("gradient needs at least 1 step, got %d", n)
+	}
+
+	ease := opts.Easing
+	if ease == nil {
+		ease = EaseLinear
+	}
+
+	segments := len(stops) - 1
+	colors := make([]NamedColor, n)
+	for i := range colors {
+		pos := 0.0
+		if n > 1 {
+			pos = ease(float64(i)/float64(n-1)) * float64(segments)
+		}
+
+		// Pick the pair of stops around pos, keeping the last step on the last pair
+		seg := min(max(int(math.Floor(pos)), 0), segments-1)
+		c := color.Interpolate(stops[seg], stops[seg+1], pos-float64(seg), opts.Space, opts.Hue)
+
+		colors[i] = NamedColor{Name: fmt.Sprintf("Step %d", i+1), Color: c}
+	}
+
+	return colors, nil
+}
+
+// NewGradient creates a palette of n colors spread along the stops. See Gradient.
+func NewGradient(name string, stops []color.Color, n int, opts GradientOptions) (*Palette, error) {
+	colors, err := Gradient(stops, n, opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithColors(name, colors...), nil
+}
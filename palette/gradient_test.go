@@ -0,0 +1,119 @@
+package palette
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestGradient(t *testing.T) {
+	black, white := color.NewRGB(0, 0, 0), color.NewRGB(255, 255, 255)
+	red, blue := color.NewRGB(255, 0, 0), color.NewRGB(0, 0, 255)
+
+	tests := map[string]struct {
+		stops []color.Color
+		n     int
+		opts  GradientOptions
+		want  []color.RGB
+	}{
+		"Two stops in sRGB": {
+			stops: []color.Color{black, white},
+			n:     3,
+			opts:  GradientOptions{Space: color.InterpolateSRGB},
+			want:  []color.RGB{black, color.NewRGB(128, 128, 128), white},
+		},
+		"Three stops": {
+			stops: []color.Color{red, white, blue},
+			n:     5,
+			opts:  GradientOptions{Space: color.InterpolateSRGB},
+			want:  []color.RGB{red, color.NewRGB(255, 128, 128), white, color.NewRGB(128, 128, 255), blue},
+		},
+		"Single step": {
+			stops: []color.Color{red, blue},
+			n:     1,
+			want:  []color.RGB{red},
+		},
+		"Ease in": {
+			stops: []color.Color{black, white},
+			n:     3,
+			opts:  GradientOptions{Space: color.InterpolateSRGB, Easing: EaseIn},
+			want:  []color.RGB{black, color.NewRGB(64, 64, 64), white},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Gradient(tt.stops, tt.n, tt.opts)
+			if err != nil {
+				t.Fatalf("Gradient() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Gradient() length = %d, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if rgb := got[i].Color.ToRGB(); rgb != want {
+					t.Errorf("Gradient()[%d] = %v, want %v", i, rgb, want)
+				}
+			}
+			if got[0].Name != "Step 1" {
+				t.Errorf("Gradient()[0].Name = %q, want Step 1", got[0].Name)
+			}
+		})
+	}
+}
+
+func TestGradientHue(t *testing.T) {
+	stops := []color.Color{color.NewOKLCH(0.7, 0.1, 350), color.NewOKLCH(0.7, 0.1, 10)}
+
+	tests := map[string]struct {
+		hue  color.HueInterpolation
+		want float64
+	}{
+		"Shorter": {color.HueShorter, 0},
+		"Longer":  {color.HueLonger, 180},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Gradient(stops, 3, GradientOptions{Space: color.InterpolateOKLCH, Hue: tt.hue})
+			if err != nil {
+				t.Fatalf("Gradient() error = %v", err)
+			}
+			mid := got[1].Color.(color.OKLCH)
+			if d := math.Abs(math.Remainder(mid.H-tt.want, 360)); d > 1e-6 {
+				t.Errorf("Gradient() middle hue = %.4f°, want %.4f°", mid.H, tt.want)
+			}
+		})
+	}
+}
+
+func TestGradientErrors(t *testing.T) {
+	red, blue := color.NewRGB(255, 0, 0), color.NewRGB(0, 0, 255)
+
+	tests := map[string]struct {
+		stops []color.Color
+		n     int
+	}{
+		"One stop":   {[]color.Color{red}, 5},
+		"Zero steps": {[]color.Color{red, blue}, 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Gradient(tt.stops, tt.n, GradientOptions{}); err == nil {
+				t.Error("Gradient() expected error")
+			}
+		})
+	}
+}
+
+func TestNewGradient(t *testing.T) {
+	p, err := NewGradient("Ramp", []color.Color{color.NewRGB(255, 0, 0), color.NewRGB(0, 0, 255)}, 7, GradientOptions{Easing: EaseInOut})
+	if err != nil {
+		t.Fatalf("NewGradient() error = %v", err)
+	}
+	if p.Name != "Ramp" || p.Len() != 7 {
+		t.Errorf("NewGradient() = %q with %d colors, want Ramp with 7", p.Name, p.Len())
+	}
+}
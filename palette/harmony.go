@@ -0,0 +1,179 @@
+package palette
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kennyp/palette/color"
+)
+
+//go:generate go tool stringer -type=Harmony -trimprefix=Harmony
+type Harmony int // Color harmony scheme
+
+const (
+	HarmonyComplementary      Harmony = iota // Base and its opposite hue
+	HarmonySplitComplementary                // Base and the two hues either side of its opposite
+	HarmonyAnalogous                         // Neighboring hues centered on the base
+	HarmonyTriadic                           // Three hues 120° apart
+	HarmonyTetradic                          // Two complementary pairs
+	HarmonyMonochromatic                     // The base hue at several lightness levels
+)
+
+// ParseHarmony parses a harmony scheme name: "complementary", "split-complementary",
+// "analogous", "triadic", "tetradic" or "monochromatic".
+func ParseHarmony(s string) (Harmony, error) {
+	switch strings.ToLower(s) {
+	case "complementary", "complement":
+		return HarmonyComplementary, nil
+	case "split-complementary", "splitcomplementary", "split":
+		return HarmonySplitComplementary, nil
+	case "analogous":
+		return HarmonyAnalogous, nil
+	case "triadic", "triad":
+		return HarmonyTriadic, nil
+	case "tetradic", "tetrad", "square":
+		return HarmonyTetradic, nil
+	case "monochromatic", "mono":
+		return HarmonyMonochromatic, nil
+	default:
+		return 0, fmt.Errorf("invalid harmony: %s (must be one of: complementary, split-complementary, analogous, triadic, tetradic, monochromatic)", s)
+	}
+}
+
+// HarmonyOptions controls how a harmony is generated. The zero value rotates hues
+// in OKLCH with each scheme's usual angles and leaves colors unmapped.
+type HarmonyOptions struct {
+	CIELCh bool            // Rotate hues in CIE LCh instead of OKLCH
+	Angle  float64         // Hue spread for split-complementary (30°), analogous (30°) and tetradic (90°)
+	Count  int             // Number of colors for analogous (3) and monochromatic (5)
+	Gamut  *color.RGBSpace // If set, colors outside this gamut are brought in by chroma reduction
+}
+
+// NewHarmony creates a palette from a base color using a harmony scheme. The base color
+// is included unchanged and named "Base"; the other colors keep its lightness and
+// chroma and are named by their hue rotation, such as "Hue +180°". Monochromatic
+// palettes instead hold the base hue and chroma at evenly spaced lightness levels,
+// dark to light, with the base in place of the nearest level.
+func NewHarmony(name string, base color.Color, harmony Harmony, opts HarmonyOptions) (*Palette, error) {
+	angle := opts.Angle
+	if angle == 0 {
+		angle = 30
+		if harmony == HarmonyTetradic {
+			angle = 90
+		}
+	}
+
+	count := opts.Count
+	if count == 0 {
+		count = 3
+		if harmony == HarmonyMonochromatic {
+			count = 5
+		}
+	}
+	if count < 1 {
+		return nil, fmt.Errorf("harmony needs at least 1 color, got %d", count)
+	}
+
+	var offsets []float64
+	switch harmony {
+	case HarmonyComplementary:
+		offsets = []float64{0, 180}
+	case HarmonySplitComplementary:
+		offsets = []float64{0, 180 - angle, 180 + angle}
+	case HarmonyAnalogous:
+		for i := range count {
+			offsets = append(offsets, float64(i-(count-1)/2)*angle)
+		}
+	case HarmonyTriadic:
+		offsets = []float64{0, 120, 240}
+	case HarmonyTetradic:
+		offsets = []float64{0, angle, 180, 180 + angle}
+	case HarmonyMonochromatic:
+		return newMonochromatic(name, base, count, opts), nil
+	default:
+		return nil, fmt.Errorf("invalid harmony: %v", harmony)
+	}
+
+	p := New(name)
+	for _, offset := range offsets {
+		if offset == 0 {
+			p.Add(base, "Base")
+			continue
+		}
+		c := rotateHue(base, offset, opts.CIELCh)
+		p.Add(fitHarmony(c, base, opts), fmt.Sprintf("Hue %+g°", offset))
+	}
+	return p, nil
+}
+
+// newMonochromatic builds a monochromatic harmony of count lightness levels.
+func newMonochromatic(name string, base color.Color, count int, opts HarmonyOptions) *Palette {
+	// Lightness levels run from 20% to 90% of the scale, leaving out black and white
+	scale := 1.0
+	var baseL float64
+	if opts.CIELCh {
+		scale = 100
+		baseL = base.ToLAB64().L
+	} else {
+		baseL = base.ToXYZ().ToOKLab().L
+	}
+
+	levels := make([]float64, count)
+	nearest := 0
+	for i := range levels {
+		levels[i] = 0.55 * scale
+		if count > 1 {
+			levels[i] = (0.2 + 0.7*float64(i)/float64(count-1)) * scale
+		}
+		if math.Abs(levels[i]-baseL) < math.Abs(levels[nearest]-baseL) {
+			nearest = i
+		}
+	}
+
+	p := New(name)
+	for i, l := range levels {
+		if i == nearest {
+			p.Add(base, "Base")
+			continue
+		}
+
+		var c color.Color
+		if opts.CIELCh {
+			lch := base.ToLAB64().ToLCH()
+			c = color.LCH{L: l, C: lch.C, H: lch.H}
+		} else {
+			oklch := base.ToXYZ().ToOKLCH()
+			c = color.OKLCH{L: l, C: oklch.C, H: oklch.H}
+		}
+		p.Add(fitHarmony(c, base, opts), fmt.Sprintf("Lightness %.0f%%", l/scale*100))
+	}
+	return p
+}
+
+// rotateHue turns the hue of c by offset degrees in CIE LCh or OKLCH.
+func rotateHue(c color.Color, offset float64, cieLCh bool) color.Color {
+	if cieLCh {
+		lch := c.ToLAB64().ToLCH()
+		return color.NewLCH(lch.L, lch.C, lch.H+offset)
+	}
+	oklch := c.ToXYZ().ToOKLCH()
+	return color.NewOKLCH(oklch.L, oklch.C, oklch.H+offset)
+}
+
+// fitHarmony maps a generated color into the requested gamut, reducing chroma in the
+// space it was generated in, and gives it the base color's alpha.
+func fitHarmony(c, base color.Color, opts HarmonyOptions) color.Color {
+	if opts.Gamut != nil && !opts.Gamut.InGamut(c) {
+		mapping := color.GamutMappingCSS
+		if opts.CIELCh {
+			mapping = color.GamutMappingLChChroma
+		}
+		c = opts.Gamut.MapToGamut(c, mapping)
+	}
+
+	if alpha := color.AlphaOf(base); alpha < 1 {
+		return color.WithAlpha(c, alpha)
+	}
+	return c
+}
@@ -0,0 +1,146 @@
+package palette
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestNewHarmony(t *testing.T) {
+	base := color.NewOKLCH(0.65, 0.1, 40)
+
+	tests := map[string]struct {
+		harmony Harmony
+		opts    HarmonyOptions
+		want    []float64 // Hue offsets from the base
+	}{
+		"Complementary":       {HarmonyComplementary, HarmonyOptions{}, []float64{0, 180}},
+		"Split complementary": {HarmonySplitComplementary, HarmonyOptions{}, []float64{0, 150, 210}},
+		"Split custom angle":  {HarmonySplitComplementary, HarmonyOptions{Angle: 20}, []float64{0, 160, 200}},
+		"Analogous":           {HarmonyAnalogous, HarmonyOptions{}, []float64{-30, 0, 30}},
+		"Analogous count 4":   {HarmonyAnalogous, HarmonyOptions{Count: 4, Angle: 15}, []float64{-15, 0, 15, 30}},
+		"Triadic":             {HarmonyTriadic, HarmonyOptions{}, []float64{0, 120, 240}},
+		"Tetradic":            {HarmonyTetradic, HarmonyOptions{}, []float64{0, 90, 180, 270}},
+		"Tetradic rectangle":  {HarmonyTetradic, HarmonyOptions{Angle: 60}, []float64{0, 60, 180, 240}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewHarmony("Test", base, tt.harmony, tt.opts)
+			if err != nil {
+				t.Fatalf("NewHarmony() error = %v", err)
+			}
+			if p.Len() != len(tt.want) {
+				t.Fatalf("NewHarmony() length = %d, want %d", p.Len(), len(tt.want))
+			}
+
+			for i, offset := range tt.want {
+				c := p.Colors[i].Color.ToXYZ().ToOKLCH()
+				if d := math.Abs(math.Remainder(c.H-base.H-offset, 360)); d > 1e-6 {
+					t.Errorf("color %d hue = %.2f°, want %.2f°", i, c.H, base.H+offset)
+				}
+				if math.Abs(c.L-base.L) > 1e-6 || math.Abs(c.C-base.C) > 1e-6 {
+					t.Errorf("color %d = %v, want lightness and chroma of %v", i, c, base)
+				}
+			}
+			for _, c := range p.Colors {
+				if c.Name == "Base" && c.Color != base {
+					t.Errorf("Base = %v, want %v", c.Color, base)
+				}
+			}
+		})
+	}
+}
+
+func TestNewHarmonyLCh(t *testing.T) {
+	base := color.NewRGB(200, 80, 40)
+	want := base.ToLAB64().ToLCH()
+
+	p, err := NewHarmony("Test", base, HarmonyComplementary, HarmonyOptions{CIELCh: true})
+	if err != nil {
+		t.Fatalf("NewHarmony() error = %v", err)
+	}
+
+	got, ok := p.Colors[1].Color.(color.LCH)
+	if !ok {
+		t.Fatalf("complement type = %T, want color.LCH", p.Colors[1].Color)
+	}
+	if math.Abs(got.H-math.Mod(want.H+180, 360)) > 1e-9 || got.L != want.L || got.C != want.C {
+		t.Errorf("complement = %v, want hue of %v rotated by 180°", got, want)
+	}
+	if p.Colors[1].Name != "Hue +180°" {
+		t.Errorf("complement name = %q, want Hue +180°", p.Colors[1].Name)
+	}
+}
+
+func TestNewHarmonyMonochromatic(t *testing.T) {
+	base := color.NewOKLCH(0.4, 0.1, 250)
+
+	p, err := NewHarmony("Test", base, HarmonyMonochromatic, HarmonyOptions{})
+	if err != nil {
+		t.Fatalf("NewHarmony() error = %v", err)
+	}
+	if p.Len() != 5 {
+		t.Fatalf("NewHarmony() length = %d, want 5", p.Len())
+	}
+
+	// Levels are 20%, 37.5%, 55%, 72.5% and 90%; the base replaces 37.5%
+	wantNames := []string{"Lightness 20%", "Base", "Lightness 55%", "Lightness 72%", "Lightness 90%"}
+	prevL := 0.0
+	for i, c := range p.Colors {
+		if c.Name != wantNames[i] {
+			t.Errorf("color %d name = %q, want %q", i, c.Name, wantNames[i])
+		}
+		oklch := c.Color.ToXYZ().ToOKLCH()
+		if oklch.L <= prevL {
+			t.Errorf("color %d lightness %.3f not above %.3f", i, oklch.L, prevL)
+		}
+		if math.Abs(oklch.H-250) > 1e-6 {
+			t.Errorf("color %d hue = %.2f°, want 250°", i, oklch.H)
+		}
+		prevL = oklch.L
+	}
+}
+
+func TestNewHarmonyGamut(t *testing.T) {
+	base := color.NewRGB(0, 200, 255)
+
+	p, err := NewHarmony("Test", base, HarmonyTriadic, HarmonyOptions{Gamut: color.SRGB})
+	if err != nil {
+		t.Fatalf("NewHarmony() error = %v", err)
+	}
+	for _, c := range p.Colors {
+		if !color.SRGB.InGamut(c.Color) {
+			t.Errorf("%s = %v, outside sRGB", c.Name, c.Color)
+		}
+	}
+}
+
+func TestParseHarmony(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    Harmony
+		wantErr bool
+	}{
+		"Complementary": {"complementary", HarmonyComplementary, false},
+		"Split":         {"split-complementary", HarmonySplitComplementary, false},
+		"Analogous":     {"Analogous", HarmonyAnalogous, false},
+		"Triadic":       {"triadic", HarmonyTriadic, false},
+		"Tetradic":      {"TETRADIC", HarmonyTetradic, false},
+		"Monochromatic": {"monochromatic", HarmonyMonochromatic, false},
+		"Invalid":       {"pentadic", 0, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseHarmony(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseHarmony() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseHarmony() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package palette
+
+import (
+	imagecolor "image/color"
+
+	"github.com/kennyp/palette/color"
+)
+
+// FromImagePalette creates a palette from an image/color.Palette, such as those in
+// image/color/palette or an image.Paletted's Palette. Colors are unnamed.
+func FromImagePalette(name string, colors imagecolor.Palette) *Palette {
+	p := New(name)
+	for _, c := range colors {
+		p.AddColor(color.FromImageColor(c))
+	}
+	return p
+}
+
+// ToImagePalette returns the palette's colors as an image/color.Palette, for
+// example to build an image.Paletted.
+func (p *Palette) ToImagePalette() imagecolor.Palette {
+	colors := make(imagecolor.Palette, len(p.Colors))
+	for i, c := range p.Colors {
+		colors[i] = color.ToImageColor(c.Color)
+	}
+	return colors
+}
@@ -0,0 +1,52 @@
+package palette
+
+import (
+	"image"
+	imagecolor "image/color"
+	stdpalette "image/color/palette"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestFromImagePalette(t *testing.T) {
+	p := FromImagePalette("Web Safe", stdpalette.WebSafe)
+
+	if p.Name != "Web Safe" || p.Len() != len(stdpalette.WebSafe) {
+		t.Fatalf("FromImagePalette() = %q with %d colors, want Web Safe with %d", p.Name, p.Len(), len(stdpalette.WebSafe))
+	}
+
+	c, _ := p.Get(1)
+	if want := color.NewRGB(0x00, 0x00, 0x33); c.Color != want {
+		t.Errorf("FromImagePalette() color 1 = %v, want %v", c.Color, want)
+	}
+}
+
+func TestToImagePalette(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+	p.Add(color.NewCMYK(100, 0, 0, 0), "Cyan")
+	p.Add(color.WithAlpha(color.NewRGB(0, 0, 255), 0), "Clear")
+
+	colors := p.ToImagePalette()
+	if len(colors) != 3 {
+		t.Fatalf("ToImagePalette() length = %d, want 3", len(colors))
+	}
+
+	// The result works with image.Paletted
+	img := image.NewPaletted(image.Rect(0, 0, 1, 1), colors)
+	img.Set(0, 0, imagecolor.RGBA{R: 250, G: 10, B: 10, A: 255})
+	if got := img.ColorIndexAt(0, 0); got != 0 {
+		t.Errorf("image.Paletted index = %d, want 0 (Red)", got)
+	}
+	if _, _, _, a := colors[2].RGBA(); a != 0 {
+		t.Errorf("ToImagePalette() alpha = %d, want 0", a)
+	}
+
+	roundTrip := FromImagePalette("Test", colors)
+	for i, c := range p.Colors {
+		if got, _ := roundTrip.Get(i); got.Color.ToRGB() != c.Color.ToRGB() {
+			t.Errorf("round trip color %d = %v, want %v", i, got.Color, c.Color)
+		}
+	}
+}
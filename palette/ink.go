@@ -0,0 +1,44 @@
+package palette
+
+import "github.com/kennyp/palette/color"
+
+// InkCoverage is the CMYK ink coverage of one palette color.
+type InkCoverage struct {
+	Index     int     `json:"index"`      // Position of the color in the palette
+	Name      string  `json:"name"`       // Name of the color
+	C         float64 `json:"c"`          // Cyan coverage in percent
+	M         float64 `json:"m"`          // Magenta coverage in percent
+	Y         float64 `json:"y"`          // Yellow coverage in percent
+	K         float64 `json:"k"`          // Black coverage in percent
+	Total     float64 `json:"total"`      // Total area coverage in percent, 0-400
+	OverLimit bool    `json:"over_limit"` // Total is above the separation's ink limit
+}
+
+// InkCoverage returns the ink coverage of every palette color. CMYK colors report the
+// inks they already have; other colors are separated with sep. Colors whose total
+// coverage is above sep.InkLimit are marked, so a palette converted without a limit
+// can be checked against one.
+func (p *Palette) InkCoverage(sep color.Separation) []InkCoverage {
+	coverage := make([]InkCoverage, len(p.Colors))
+	for i, c := range p.Colors {
+		var inks color.CMYK64
+		if c.Color.ColorSpace() == "CMYK" {
+			inks = c.Color.ToCMYK64()
+		} else {
+			inks = sep.Separate(c.Color)
+		}
+
+		total := inks.TotalInk()
+		coverage[i] = InkCoverage{
+			Index:     i,
+			Name:      c.Name,
+			C:         inks.C * 100,
+			M:         inks.M * 100,
+			Y:         inks.Y * 100,
+			K:         inks.K * 100,
+			Total:     total,
+			OverLimit: sep.InkLimit > 0 && total > sep.InkLimit+1e-9,
+		}
+	}
+	return coverage
+}
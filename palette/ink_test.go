@@ -0,0 +1,61 @@
+package palette
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestInkCoverage(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewCMYK(100, 100, 100, 100), "Registration")
+	p.Add(color.NewRGB(0, 0, 0), "Black")
+	p.Add(color.NewRGB(128, 128, 128), "Gray")
+	p.Add(color.NewRGB(255, 255, 255), "White")
+
+	tests := map[string]struct {
+		separation color.Separation
+		wantTotal  []float64
+		wantOver   []bool
+	}{
+		"Maximum": {
+			color.Separation{},
+			[]float64{400, 100, 100 - 100*128.0/255, 0},
+			[]bool{false, false, false, false},
+		},
+		"None with limit": {
+			color.Separation{BlackGeneration: color.BlackGenerationNone, InkLimit: 300},
+			[]float64{400, 300, 3 * (100 - 100*128.0/255), 0},
+			[]bool{true, false, false, false},
+		},
+		"None with lower limit": {
+			color.Separation{BlackGeneration: color.BlackGenerationNone, InkLimit: 240},
+			[]float64{400, 100, 3 * (100 - 100*128.0/255), 0},
+			[]bool{true, false, false, false},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			coverage := p.InkCoverage(tt.separation)
+			if len(coverage) != p.Len() {
+				t.Fatalf("InkCoverage() returned %d colors, want %d", len(coverage), p.Len())
+			}
+			for i, c := range coverage {
+				if c.Index != i || c.Name != p.Colors[i].Name {
+					t.Errorf("InkCoverage()[%d] = %d %q, want %d %q", i, c.Index, c.Name, i, p.Colors[i].Name)
+				}
+				if math.Abs(c.Total-tt.wantTotal[i]) > 1e-6 {
+					t.Errorf("InkCoverage()[%d].Total = %.2f, want %.2f", i, c.Total, tt.wantTotal[i])
+				}
+				if math.Abs(c.C+c.M+c.Y+c.K-c.Total) > 1e-9 {
+					t.Errorf("InkCoverage()[%d] inks %.2f/%.2f/%.2f/%.2f do not add up to %.2f", i, c.C, c.M, c.Y, c.K, c.Total)
+				}
+				if c.OverLimit != tt.wantOver[i] {
+					t.Errorf("InkCoverage()[%d].OverLimit = %v, want %v", i, c.OverLimit, tt.wantOver[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package palette
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kennyp/palette/color"
+)
+
+// paletteJSON is the JSON form of a Palette, with its metadata.
+type paletteJSON struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Colors      []NamedColor   `json:"colors"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+}
+
+// MarshalJSON encodes the palette as a JSON object with its name, description, colors
+// and metadata. Each color is tagged with its type (see color.MarshalJSON), so
+// UnmarshalJSON restores the same colors.
+//
+// Metadata values are encoded with encoding/json and come back as JSON types: a
+// number stored as a uint16 is read back as a float64.
+func (p Palette) MarshalJSON() ([]byte, error) {
+	colors := p.Colors
+	if colors == nil {
+		colors = []NamedColor{}
+	}
+	return json.Marshal(paletteJSON{
+		Name:        p.Name,
+		Description: p.Description,
+		Colors:      colors,
+		Metadata:    p.metadata,
+	})
+}
+
+// UnmarshalJSON decodes a palette encoded by MarshalJSON.
+func (p *Palette) UnmarshalJSON(data []byte) error {
+	var decoded paletteJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*p = *New(decoded.Name)
+	p.Description = decoded.Description
+	p.Colors = append(p.Colors, decoded.Colors...)
+	for key, value := range decoded.Metadata {
+		p.SetMetadata(key, value)
+	}
+	return nil
+}
+
+// GobEncode encodes the palette for encoding/gob as its JSON form, so colors of every
+// type and the metadata are kept without registering any types.
+func (p Palette) GobEncode() ([]byte, error) {
+	return p.MarshalJSON()
+}
+
+// GobDecode decodes a palette encoded by GobEncode.
+func (p *Palette) GobDecode(data []byte) error {
+	return p.UnmarshalJSON(data)
+}
+
+// namedColorJSON is the JSON form of a NamedColor.
+type namedColorJSON struct {
+	Name     string          `json:"name,omitempty"`
+	Color    json.RawMessage `json:"color"`
+	Metadata map[string]any  `json:"metadata,omitempty"`
+}
+
+// MarshalJSON encodes the color as a tagged union: its name, a color object tagged
+// with the color's type and its metadata, such as
+//
+//	{"name":"Red","color":{"type":"RGB","r":255,"g":0,"b":0},"metadata":{"key":"RED001"}}
+func (c NamedColor) MarshalJSON() ([]byte, error) {
+	encoded := namedColorJSON{Name: c.Name, Color: json.RawMessage("null"), Metadata: c.Metadata}
+	if c.Color != nil {
+		data, err := color.MarshalJSON(c.Color)
+		if err != nil {
+			return nil, fmt.Errorf("color %q: %w", c.Name, err)
+		}
+		encoded.Color = data
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes a color encoded by MarshalJSON.
+func (c *NamedColor) UnmarshalJSON(data []byte) error {
+	var decoded namedColorJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*c = NamedColor{Name: decoded.Name}
+	for key, value := range decoded.Metadata {
+		c.SetMetadata(key, value)
+	}
+	if len(decoded.Color) == 0 || string(decoded.Color) == "null" {
+		return nil
+	}
+
+	var err error
+	if c.Color, err = color.UnmarshalJSON(decoded.Color); err != nil {
+		return fmt.Errorf("color %q: %w", decoded.Name, err)
+	}
+	return nil
+}
+
+// GobEncode encodes the color for encoding/gob as its JSON form, so its metadata is
+// kept.
+func (c NamedColor) GobEncode() ([]byte, error) {
+	return c.MarshalJSON()
+}
+
+// GobDecode decodes a color encoded by GobEncode.
+func (c *NamedColor) GobDecode(data []byte) error {
+	return c.UnmarshalJSON(data)
+}
+
+// MarshalText encodes the color as its quoted name followed by the text form of the
+// color (see color.MarshalText), such as `"Red" RGB(255, 0, 0)`. Unnamed colors are
+// just the color. The text form has no metadata; use MarshalJSON to keep it.
+func (c NamedColor) MarshalText() ([]byte, error) {
+	if c.Color == nil {
+		return nil, fmt.Errorf("color %q: no color", c.Name)
+	}
+	text, err := color.MarshalText(c.Color)
+	if err != nil {
+		return nil, fmt.Errorf("color %q: %w", c.Name, err)
+	}
+	if c.Name == "" {
+		return text, nil
+	}
+	return fmt.Appendf(nil, "%s %s", strconv.Quote(c.Name), text), nil
+}
+
+// UnmarshalText decodes a color encoded by MarshalText.
+func (c *NamedColor) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+
+	var name string
+	if strings.HasPrefix(s, `"`) {
+		quoted, err := strconv.QuotedPrefix(s)
+		if err != nil {
+			return fmt.Errorf("invalid color name in %q: %w", s, err)
+		}
+		name, _ = strconv.Unquote(quoted)
+		s = s[len(quoted):]
+	}
+
+	decoded, err := color.UnmarshalText([]byte(s))
+	if err != nil {
+		return fmt.Errorf("color %q: %w", name, err)
+	}
+	*c = NamedColor{Name: name, Color: decoded}
+	return nil
+}
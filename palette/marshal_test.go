@@ -0,0 +1,169 @@
+package palette
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func marshalTestPalette() *Palette {
+	p := New("Brand")
+	p.Description = "Brand colors"
+	p.Add(color.NewRGB(228, 0, 43), "Red")
+	p.Add(color.NewCMYK64(0.1, 0.2, 0.3, 0.4), "")
+	p.Add(color.WithAlpha(color.NewOKLCH(0.6, 0.1, 250), 0.5), "Glass")
+	p.Add(color.NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", color.NewLAB64WithWhite(47, 72, 42, color.D50)), "185")
+	p.Add(color.DisplayP3.New(0, 1, 0), `Green "P3"`)
+	p.Colors[0].SetMetadata(MetadataKey, "RED001")
+	p.Colors[0].SetMetadata(MetadataTags, []string{"brand", "primary"})
+	p.SetMetadata("format", "JSON")
+	p.SetMetadata("version", 2.0)
+	return p
+}
+
+func TestPaletteJSON(t *testing.T) {
+	p := marshalTestPalette()
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got Palette
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+	}
+	if !reflect.DeepEqual(&got, p) {
+		t.Errorf("json.Unmarshal(%s) = %#v, want %#v", data, &got, p)
+	}
+}
+
+func TestPaletteJSONEmbedded(t *testing.T) {
+	type document struct {
+		Title   string  `json:"title"`
+		Palette Palette `json:"palette"`
+	}
+
+	doc := document{Title: "Style guide", Palette: *marshalTestPalette()}
+	data, err := json.Marshal(doc) // Not addressable, so value receivers are needed
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got document
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+	}
+	if !reflect.DeepEqual(got, doc) {
+		t.Errorf("json.Unmarshal(%s) = %#v, want %#v", data, got, doc)
+	}
+}
+
+func TestPaletteGob(t *testing.T) {
+	p := marshalTestPalette()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(p); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	var got Palette
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(&got, p) {
+		t.Errorf("Decode() = %#v, want %#v", &got, p)
+	}
+}
+
+func TestNamedColorJSON(t *testing.T) {
+	tests := map[string]struct {
+		color NamedColor
+		want  string
+	}{
+		"Named":    {NamedColor{Name: "Red", Color: color.NewRGB(255, 0, 0)}, `{"name":"Red","color":{"type":"RGB","r":255,"g":0,"b":0}}`},
+		"Unnamed":  {NamedColor{Color: color.NewGray(0.5)}, `{"color":{"type":"Gray","y":0.5}}`},
+		"No color": {NamedColor{Name: "Empty"}, `{"name":"Empty","color":null}`},
+		"Metadata": {
+			NamedColor{Name: "Red", Color: color.NewRGB(255, 0, 0), Metadata: map[string]any{MetadataKey: "RED001"}},
+			`{"name":"Red","color":{"type":"RGB","r":255,"g":0,"b":0},"metadata":{"key":"RED001"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(tt.color)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+
+			var got NamedColor
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if !reflect.DeepEqual(got, tt.color) {
+				t.Errorf("json.Unmarshal(%s) = %#v, want %#v", data, got, tt.color)
+			}
+		})
+	}
+
+	var got NamedColor
+	if err := json.Unmarshal([]byte(`{"name":"Bad","color":{"type":"CMY"}}`), &got); err == nil {
+		t.Errorf("json.Unmarshal() of an unknown color type succeeded, want error")
+	}
+}
+
+func TestNamedColorText(t *testing.T) {
+	tests := map[string]struct {
+		color NamedColor
+		want  string
+	}{
+		"Named":   {NamedColor{Name: "Red", Color: color.NewRGB(255, 0, 0)}, `"Red" RGB(255, 0, 0)`},
+		"Unnamed": {NamedColor{Color: color.NewRGB64(1, 0.5, 0)}, `RGB64(1, 0.5, 0)`},
+		"Quotes":  {NamedColor{Name: `Say "hi"`, Color: color.NewGray(1)}, `"Say \"hi\"" Gray(1)`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			text, err := tt.color.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %s, want %s", text, tt.want)
+			}
+
+			var got NamedColor
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%s) error = %v", text, err)
+			}
+			if !reflect.DeepEqual(got, tt.color) {
+				t.Errorf("UnmarshalText(%s) = %#v, want %#v", text, got, tt.color)
+			}
+		})
+	}
+}
+
+func TestNamedColorGob(t *testing.T) {
+	want := NamedColor{Name: "185", Color: color.NewRGB(228, 0, 43), Metadata: map[string]any{MetadataSource: "PANTONE+ Solid Coated"}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	var got NamedColor
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode() = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package palette
+
+import (
+	"fmt"
+
+	"github.com/kennyp/palette/color"
+)
+
+// AutoName names every unnamed color in the palette after the nearest color in the
+// name set, such as "Burnt Sienna". Names stay unique: a second color nearest to
+// "Navy" becomes "Navy 2", and names already in the palette are not reused.
+func (p *Palette) AutoName(set *color.NameSet) {
+	used := make(map[string]bool, len(p.Colors))
+	for _, c := range p.Colors {
+		if c.Name != "" {
+			used[c.Name] = true
+		}
+	}
+
+	for i, c := range p.Colors {
+		if c.Name != "" {
+			continue
+		}
+
+		base, _ := set.Nearest(c.Color)
+		name := base
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s %d", base, n)
+		}
+
+		used[name] = true
+		p.Colors[i].Name = name
+	}
+}
@@ -0,0 +1,35 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/kennyp/palette/color"
+)
+
+func TestAutoName(t *testing.T) {
+	p := New("Test")
+	p.AddColor(color.NewRGB(255, 0, 0))
+	p.Add(color.NewRGB(0, 0, 128), "Brand Navy")
+	p.AddColor(color.NewRGB(254, 1, 1))
+	p.AddColor(color.NewRGB(0x95, 0x45, 0x35))
+	p.Add(color.NewRGB(0, 0, 255), "blue")
+	p.AddColor(color.NewRGB(0, 0, 250))
+
+	p.AutoName(color.CSSNames())
+
+	want := []string{"red", "Brand Navy", "red 2", "brown", "blue", "blue 2"}
+	for i, name := range want {
+		if p.Colors[i].Name != name {
+			t.Errorf("color %d name = %q, want %q", i, p.Colors[i].Name, name)
+		}
+	}
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	p.AddColor(color.NewRGB(0x95, 0x45, 0x35))
+	p.AutoName(color.ExtendedNames())
+	if got := p.Colors[6].Name; got != "Chestnut" {
+		t.Errorf("extended name = %q, want Chestnut", got)
+	}
+}
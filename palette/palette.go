@@ -17,10 +17,46 @@ type Palette struct {
 	metadata    map[string]any
 }
 
-// NamedColor represents a color with an optional name.
+// NamedColor represents a color with an optional name and metadata.
 type NamedColor struct {
-	Name  string      `json:"name,omitempty"`
-	Color color.Color `json:"color"`
+	Name     string         `json:"name,omitempty"`
+	Color    color.Color    `json:"color"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// Well-known NamedColor metadata keys. Codecs that can store these properties read
+// and write them under these keys.
+const (
+	MetadataKey         = "key"         // Catalog code of the color, such as the 6-character key of an ACB color
+	MetadataTags        = "tags"        // Tags of the color, as a []string
+	MetadataDescription = "description" // Description of the color
+	MetadataSource      = "source"      // Where the color came from, such as a book or file name
+)
+
+// SetMetadata sets a metadata value for the color. Tags given as a []any of strings,
+// as decoded from JSON, are stored as a []string.
+func (c *NamedColor) SetMetadata(key string, value any) {
+	if c.Metadata == nil {
+		c.Metadata = make(map[string]any)
+	}
+	if values, ok := value.([]any); ok && key == MetadataTags {
+		tags := make([]string, len(values))
+		for i, v := range values {
+			if tags[i], ok = v.(string); !ok {
+				break
+			}
+		}
+		if ok {
+			value = tags
+		}
+	}
+	c.Metadata[key] = value
+}
+
+// GetMetadata gets a metadata value from the color.
+func (c NamedColor) GetMetadata(key string) (any, bool) {
+	value, exists := c.Metadata[key]
+	return value, exists
 }
 
 // New creates a new empty palette with the given name.
@@ -127,6 +163,9 @@ func (p *Palette) Clone() *Palette {
 	}
 
 	copy(clone.Colors, p.Colors)
+	for i, c := range clone.Colors {
+		clone.Colors[i].Metadata = maps.Clone(c.Metadata)
+	}
 
 	// Copy metadata
 	maps.Copy(clone.metadata, p.metadata)
@@ -169,35 +208,99 @@ func (p *Palette) Map(mapper func(NamedColor) NamedColor) *Palette {
 }
 
 // ConvertToColorSpace returns a new palette with all colors converted to the specified color space.
+// Converted colors keep full floating-point precision so that chained conversions do not accumulate
+// rounding drift; quantization happens only when a color is exported.
+//
+// The color space is looked up by name or alias in color.DefaultColorSpaces, so registered spaces
+// can be converted to as well as the built-in ones. Unknown names return a
+// *color.UnknownColorSpaceError.
+//
+// Colors outside the gamut of the target space are clipped. Use ConvertToColorSpaceMapped to choose
+// another gamut mapping or to find out which colors were affected.
 func (p *Palette) ConvertToColorSpace(colorSpace string) (*Palette, error) {
-	// Only error for clearly invalid color space names
-	if colorSpace == "INVALID" || colorSpace == "" {
-		return nil, fmt.Errorf("invalid color space: %s", colorSpace)
+	converted, _, err := p.ConvertToColorSpaceMapped(colorSpace, color.GamutMappingClip)
+	return converted, err
+}
+
+// GamutIssue describes a color that was outside the gamut of the target space during conversion.
+type GamutIssue struct {
+	Index  int     // Position of the color in the palette
+	Name   string  // Name of the color
+	DeltaE float64 // CIEDE2000 difference between the original and the converted color
+}
+
+func (g GamutIssue) String() string {
+	return fmt.Sprintf("color %d (%s): out of gamut (ΔE2000 %.2f after mapping)", g.Index, g.Name, g.DeltaE)
+}
+
+// ConvertToColorSpaceMapped converts the palette like ConvertToColorSpace, bringing colors outside
+// the gamut of the target space in with the given mapping, and reports which colors were out of gamut.
+// The gamut is the Gamut of the color space: sRGB for RGB, HSB, HSL and CMYK, and their own for the
+// wide-gamut RGB spaces. Unbounded spaces never report issues.
+func (p *Palette) ConvertToColorSpaceMapped(colorSpace string, mapping color.GamutMapping) (*Palette, []GamutIssue, error) {
+	return p.ConvertToColorSpaceWithOptions(colorSpace, ConvertOptions{GamutMapping: mapping})
+}
+
+// ConvertOptions controls how ConvertToColorSpaceWithOptions converts colors.
+type ConvertOptions struct {
+	GamutMapping color.GamutMapping // How colors outside the target gamut are brought in
+	Separation   color.Separation   // How colors are separated into inks when converting to CMYK
+}
+
+// ConvertToColorSpaceWithOptions converts the palette like ConvertToColorSpaceMapped, also choosing
+// the black generation and ink limit of CMYK conversions. With the zero Separation, CMYK colors are
+// kept as they are; otherwise every color, CMYK or not, is separated again.
+func (p *Palette) ConvertToColorSpaceWithOptions(colorSpace string, opts ConvertOptions) (*Palette, []GamutIssue, error) {
+	mapping := opts.GamutMapping
+
+	space, err := color.LookupColorSpace(colorSpace)
+	if err != nil {
+		return nil, nil, err
 	}
-	
-	upperColorSpace := strings.ToUpper(colorSpace)
+	gamut := space.Gamut
+
+	converted := p.Map(func(c NamedColor) NamedColor {
+		source := c.Color
+		if gamut != nil && mapping != color.GamutMappingClip && !gamut.InGamut(source) {
+			source = gamut.MapToGamut(source, mapping)
+		}
 
-	return p.Map(func(c NamedColor) NamedColor {
 		var convertedColor color.Color
+		if space.Name == "CMYK" && opts.Separation != (color.Separation{}) {
+			convertedColor = opts.Separation.Separate(source)
+		} else {
+			convertedColor = space.Convert(source)
+		}
 
-		switch upperColorSpace {
-		case "RGB":
-			convertedColor = c.Color.ToRGB()
-		case "CMYK":
-			convertedColor = c.Color.ToCMYK()
-		case "LAB":
-			convertedColor = c.Color.ToLAB()
-		case "HSB":
-			convertedColor = c.Color.ToHSB()
-		default:
-			convertedColor = c.Color // Keep original for unknown but potentially valid color spaces
+		// Conversions produce opaque process colors, so carry any spot ink and alpha across
+		if spot, ok := color.AsSpot(c.Color); ok {
+			convertedColor = spot.WithAlternate(convertedColor)
+		}
+		if alpha, ok := c.Color.(color.AlphaColor); ok {
+			convertedColor = color.WithAlpha(convertedColor, alpha.Alpha)
 		}
 
 		return NamedColor{
-			Name:  c.Name,
-			Color: convertedColor,
+			Name:     c.Name,
+			Color:    convertedColor,
+			Metadata: maps.Clone(c.Metadata),
 		}
-	}), nil
+	})
+
+	var issues []GamutIssue
+	if gamut != nil {
+		for i, c := range p.Colors {
+			if !gamut.InGamut(c.Color) {
+				issues = append(issues, GamutIssue{
+					Index:  i,
+					Name:   c.Name,
+					DeltaE: color.DeltaE(c.Color, converted.Colors[i].Color, color.DeltaECIEDE2000),
+				})
+			}
+		}
+	}
+
+	return converted, issues, nil
 }
 
 // String returns a string representation of the palette.
@@ -1,7 +1,9 @@
 package palette
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/kennyp/palette/color"
@@ -314,16 +316,13 @@ func TestConvertToColorSpace(t *testing.T) {
 	}
 	
 	// Test unknown color space
-	unknown, err := p.ConvertToColorSpace("XYZ")
-	if err != nil {
-		t.Errorf("ConvertToColorSpace() should handle unknown color spaces")
+	unknown, err := p.ConvertToColorSpace("UNKNOWN")
+	var unknownErr *color.UnknownColorSpaceError
+	if !errors.As(err, &unknownErr) || unknownErr.Name != "UNKNOWN" {
+		t.Errorf("ConvertToColorSpace() error = %v, want *color.UnknownColorSpaceError", err)
 	}
-	
-	// Should keep original colors for unknown color space
-	orig, _ := p.Get(0)
-	conv, _ := unknown.Get(0)
-	if orig.Color.ColorSpace() != conv.Color.ColorSpace() {
-		t.Errorf("ConvertToColorSpace() should preserve colors for unknown color space")
+	if unknown != nil {
+		t.Errorf("ConvertToColorSpace() = %v, want nil for unknown color space", unknown)
 	}
 }
 
@@ -619,4 +618,212 @@ func TestListMetadataKeys(t *testing.T) {
 	if len(keys) != 2 {
 		t.Errorf("Expected 2 metadata keys after removal, got %d", len(keys))
 	}
-}
\ No newline at end of file
+}
+
+func TestConvertToColorSpacePreservesPrecision(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(128, 64, 192), "Purple")
+
+	// Convert through several spaces and back; quantizing at each step would drift
+	current := p
+	for _, space := range []string{"CMYK", "LAB", "HSB", "RGB"} {
+		var err error
+		current, err = current.ConvertToColorSpace(space)
+		if err != nil {
+			t.Fatalf("ConvertToColorSpace(%s) error = %v", space, err)
+		}
+	}
+
+	got, _ := current.Get(0)
+	if got.Color.ToRGB() != color.NewRGB(128, 64, 192) {
+		t.Errorf("ConvertToColorSpace() chain = %v, want RGB(128, 64, 192)", got.Color.ToRGB())
+	}
+}
+
+func TestConvertToOKLCH(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+
+	for _, space := range []string{"OKLAB", "oklch"} {
+		converted, err := p.ConvertToColorSpace(space)
+		if err != nil {
+			t.Fatalf("ConvertToColorSpace(%s) error = %v", space, err)
+		}
+
+		c, _ := converted.Get(0)
+		if !strings.EqualFold(c.Color.ColorSpace(), space) {
+			t.Errorf("ConvertToColorSpace(%s) color space = %v", space, c.Color.ColorSpace())
+		}
+		if got := c.Color.ToRGB(); got != color.NewRGB(255, 0, 0) {
+			t.Errorf("ConvertToColorSpace(%s) = %v, want RGB(255, 0, 0)", space, got)
+		}
+	}
+}
+
+func TestConvertToColorSpaceKeepsAlpha(t *testing.T) {
+	p := New("Test")
+	p.Add(color.WithAlpha(color.NewRGB(255, 0, 0), 0.5), "Glass")
+
+	converted, err := p.ConvertToColorSpace("CMYK")
+	if err != nil {
+		t.Fatalf("ConvertToColorSpace() error = %v", err)
+	}
+
+	c, _ := converted.Get(0)
+	if got := color.AlphaOf(c.Color); got != 0.5 {
+		t.Errorf("ConvertToColorSpace() alpha = %v, want 0.5", got)
+	}
+	if got := c.Color.ColorSpace(); got != "CMYK" {
+		t.Errorf("ConvertToColorSpace() color space = %v, want CMYK", got)
+	}
+}
+
+func TestConvertToColorSpaceKeepsSpot(t *testing.T) {
+	p := New("Test")
+	p.Add(color.WithAlpha(color.NewSpot("PANTONE 185 C", "PANTONE+ Solid Coated", color.NewRGB(228, 0, 43)), 0.5), "185")
+
+	converted, err := p.ConvertToColorSpace("CMYK")
+	if err != nil {
+		t.Fatalf("ConvertToColorSpace() error = %v", err)
+	}
+
+	c, _ := converted.Get(0)
+	spot, ok := color.AsSpot(c.Color)
+	if !ok || spot.Ink != "PANTONE 185 C" {
+		t.Fatalf("ConvertToColorSpace() = %v, want the PANTONE 185 C ink kept", c.Color)
+	}
+	if got := spot.Color.ColorSpace(); got != "CMYK" {
+		t.Errorf("ConvertToColorSpace() alternate color space = %v, want CMYK", got)
+	}
+	if got := color.AlphaOf(c.Color); got != 0.5 {
+		t.Errorf("ConvertToColorSpace() alpha = %v, want 0.5", got)
+	}
+}
+
+func TestConvertToRGBSpace(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+
+	for _, name := range []string{"DisplayP3", "display-p3", "AdobeRGB", "ProPhoto", "Rec2020"} {
+		t.Run(name, func(t *testing.T) {
+			converted, err := p.ConvertToColorSpace(name)
+			if err != nil {
+				t.Fatalf("ConvertToColorSpace() error = %v", err)
+			}
+
+			c, _ := converted.Get(0)
+			if _, ok := c.Color.(color.SpaceRGB); !ok {
+				t.Fatalf("ConvertToColorSpace() color = %T, want color.SpaceRGB", c.Color)
+			}
+			if got := c.Color.ToRGB(); got != color.NewRGB(255, 0, 0) {
+				t.Errorf("ToRGB() = %v, want RGB(255, 0, 0)", got)
+			}
+		})
+	}
+}
+
+func TestConvertToColorSpaceMapped(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(255, 0, 0), "Red")
+	p.Add(color.DisplayP3.New(0, 1, 0), "P3 Green")
+
+	converted, issues, err := p.ConvertToColorSpaceMapped("RGB", color.GamutMappingOKLCHChroma)
+	if err != nil {
+		t.Fatalf("ConvertToColorSpaceMapped() error = %v", err)
+	}
+
+	if len(issues) != 1 || issues[0].Index != 1 || issues[0].Name != "P3 Green" {
+		t.Fatalf("ConvertToColorSpaceMapped() issues = %v, want only P3 Green", issues)
+	}
+	if issues[0].DeltaE <= 0 {
+		t.Errorf("GamutIssue.DeltaE = %v, want > 0", issues[0].DeltaE)
+	}
+
+	red, _ := converted.Get(0)
+	if got := red.Color.ToRGB(); got != color.NewRGB(255, 0, 0) {
+		t.Errorf("in-gamut color = %v, want RGB(255, 0, 0)", got)
+	}
+
+	green, _ := converted.Get(1)
+	if !color.SRGB.InGamut(green.Color) {
+		t.Errorf("mapped color = %v, want in sRGB gamut", green.Color)
+	}
+}
+
+func TestConvertToColorSpaceMappedUnbounded(t *testing.T) {
+	p := New("Test")
+	p.Add(color.DisplayP3.New(0, 1, 0), "P3 Green")
+
+	for _, space := range []string{"LAB", "OKLCH", "DisplayP3", "Rec2020"} {
+		t.Run(space, func(t *testing.T) {
+			_, issues, err := p.ConvertToColorSpaceMapped(space, color.GamutMappingCSS)
+			if err != nil {
+				t.Fatalf("ConvertToColorSpaceMapped() error = %v", err)
+			}
+			if len(issues) != 0 {
+				t.Errorf("ConvertToColorSpaceMapped() issues = %v, want none", issues)
+			}
+		})
+	}
+}
+
+func TestConvertToColorSpaceWithOptions(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(0, 0, 0), "Black")
+	p.Add(color.NewRGB(40, 20, 60), "Aubergine")
+	p.Add(color.NewCMYK(100, 100, 100, 100), "Registration")
+
+	converted, _, err := p.ConvertToColorSpaceWithOptions("CMYK", ConvertOptions{
+		Separation: color.Separation{BlackGeneration: color.BlackGenerationNone, InkLimit: 280},
+	})
+	if err != nil {
+		t.Fatalf("ConvertToColorSpaceWithOptions() error = %v", err)
+	}
+
+	for _, c := range converted.Colors {
+		cmyk, ok := c.Color.(color.CMYK64)
+		if !ok {
+			t.Fatalf("%s = %T, want color.CMYK64", c.Name, c.Color)
+		}
+		if total := cmyk.TotalInk(); total > 280+1e-9 {
+			t.Errorf("%s total ink = %.1f%%, want at most 280%%", c.Name, total)
+		}
+	}
+
+	// The default separation keeps CMYK colors as they are
+	plain, _, err := p.ConvertToColorSpaceWithOptions("CMYK", ConvertOptions{})
+	if err != nil {
+		t.Fatalf("ConvertToColorSpaceWithOptions() error = %v", err)
+	}
+	if c, _ := plain.Get(2); c.Color.ToCMYK() != color.NewCMYK(100, 100, 100, 100) {
+		t.Errorf("Registration = %v, want CMYK(100%%, 100%%, 100%%, 100%%)", c.Color)
+	}
+}
+
+func TestNamedColorMetadata(t *testing.T) {
+	p := New("Test")
+	p.Add(color.NewRGB(228, 0, 43), "Red")
+	p.Colors[0].SetMetadata(MetadataKey, "RED001")
+	p.Colors[0].SetMetadata(MetadataTags, []string{"brand", "primary"})
+
+	if key, ok := p.Colors[0].GetMetadata(MetadataKey); !ok || key != "RED001" {
+		t.Errorf("GetMetadata(%q) = %v, %v, want RED001, true", MetadataKey, key, ok)
+	}
+	if _, ok := p.Colors[0].GetMetadata(MetadataSource); ok {
+		t.Errorf("GetMetadata(%q) found a value that was never set", MetadataSource)
+	}
+
+	clone := p.Clone()
+	clone.Colors[0].SetMetadata(MetadataKey, "RED002")
+	if key, _ := p.Colors[0].GetMetadata(MetadataKey); key != "RED001" {
+		t.Errorf("Clone() shares color metadata with the original, key = %v", key)
+	}
+
+	converted, err := p.ConvertToColorSpace("CMYK")
+	if err != nil {
+		t.Fatalf("ConvertToColorSpace() error = %v", err)
+	}
+	if !reflect.DeepEqual(converted.Colors[0].Metadata, p.Colors[0].Metadata) {
+		t.Errorf("ConvertToColorSpace() metadata = %v, want %v", converted.Colors[0].Metadata, p.Colors[0].Metadata)
+	}
+}